@@ -0,0 +1,59 @@
+package option
+
+// Sequence converts a slice of options into an option of a
+// slice. It returns `None` if any element is `None`, otherwise
+// `Some` of the contained values in order.
+func Sequence[T any](os []Option[T]) Option[[]T] {
+	result := make([]T, 0, len(os))
+	for _, o := range os {
+		if o.IsNone() {
+			return noneFrom[[]T](o)
+		}
+		result = append(result, o.data)
+	}
+	return Some(result)
+}
+
+// Traverse applies f to each element of ts and collects the
+// contained values. It stops and returns `None` at the first
+// element for which f returns `None`.
+func Traverse[T any, U any](ts []T, f func(T) Option[U]) Option[[]U] {
+	result := make([]U, 0, len(ts))
+	for _, t := range ts {
+		o := f(t)
+		if o.IsNone() {
+			return noneFrom[[]U](o)
+		}
+		result = append(result, o.data)
+	}
+	return Some(result)
+}
+
+// SequenceMap converts a map of options into an option of a
+// map. It returns `None` if any value is `None`, otherwise
+// `Some` of a map with the contained values.
+func SequenceMap[K comparable, V any](m map[K]Option[V]) Option[map[K]V] {
+	result := make(map[K]V, len(m))
+	for k, o := range m {
+		if o.IsNone() {
+			return noneFrom[map[K]V](o)
+		}
+		result[k] = o.data
+	}
+	return Some(result)
+}
+
+// TraverseMap applies f to each value of m and collects the
+// contained values under the same keys. It stops and returns
+// `None` at the first value for which f returns `None`.
+func TraverseMap[K comparable, V any, U any](m map[K]V, f func(V) Option[U]) Option[map[K]U] {
+	result := make(map[K]U, len(m))
+	for k, v := range m {
+		o := f(v)
+		if o.IsNone() {
+			return noneFrom[map[K]U](o)
+		}
+		result[k] = o.data
+	}
+	return Some(result)
+}
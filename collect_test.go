@@ -0,0 +1,152 @@
+package option_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func positive(x int) option.Option[int] {
+	if x <= 0 {
+		return option.None[int]()
+	}
+	return option.Some(x)
+}
+
+func TestSequence(t *testing.T) {
+	tests := map[string]struct {
+		values []option.Option[int]
+		result option.Option[[]int]
+	}{
+		"all_some": {
+			values: []option.Option[int]{option.Some(1), option.Some(2)},
+			result: option.Some([]int{1, 2}),
+		},
+		"one_none": {
+			values: []option.Option[int]{option.Some(1), option.None[int](), option.Some(2)},
+			result: option.None[[]int](),
+		},
+		"empty": {
+			values: nil,
+			result: option.Some([]int{}),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := option.Sequence(tc.values)
+			if result.IsSome() != tc.result.IsSome() {
+				t.FailNow()
+			}
+			if !slices.Equal(result.UnwrapOrDefault(), tc.result.UnwrapOrDefault()) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestTraverse(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		calls  int
+		result option.Option[[]int]
+	}{
+		"all_some": {
+			values: []int{1, 2},
+			calls:  2,
+			result: option.Some([]int{1, 2}),
+		},
+		"stops_at_none": {
+			values: []int{1, 0, 2},
+			calls:  2,
+			result: option.None[[]int](),
+		},
+		"empty": {
+			values: nil,
+			calls:  0,
+			result: option.Some([]int{}),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			calls := 0
+			result := option.Traverse(tc.values, func(x int) option.Option[int] {
+				calls++
+				return positive(x)
+			})
+			if calls != tc.calls {
+				t.Fail()
+			}
+			if result.IsSome() != tc.result.IsSome() {
+				t.FailNow()
+			}
+			if !slices.Equal(result.UnwrapOrDefault(), tc.result.UnwrapOrDefault()) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestSequenceMap(t *testing.T) {
+	tests := map[string]struct {
+		values map[string]option.Option[int]
+		result option.Option[map[string]int]
+	}{
+		"all_some": {
+			values: map[string]option.Option[int]{"a": option.Some(1), "b": option.Some(2)},
+			result: option.Some(map[string]int{"a": 1, "b": 2}),
+		},
+		"one_none": {
+			values: map[string]option.Option[int]{"a": option.Some(1), "b": option.None[int]()},
+			result: option.None[map[string]int](),
+		},
+		"empty": {
+			values: nil,
+			result: option.Some(map[string]int{}),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := option.SequenceMap(tc.values)
+			if result.IsSome() != tc.result.IsSome() {
+				t.FailNow()
+			}
+			if !maps.Equal(result.UnwrapOrDefault(), tc.result.UnwrapOrDefault()) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestTraverseMap(t *testing.T) {
+	tests := map[string]struct {
+		values map[string]int
+		result option.Option[map[string]int]
+	}{
+		"all_some": {
+			values: map[string]int{"a": 1, "b": 2},
+			result: option.Some(map[string]int{"a": 1, "b": 2}),
+		},
+		"one_none": {
+			values: map[string]int{"a": 1, "b": 0},
+			result: option.None[map[string]int](),
+		},
+		"empty": {
+			values: nil,
+			result: option.Some(map[string]int{}),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := option.TraverseMap(tc.values, positive)
+			if result.IsSome() != tc.result.IsSome() {
+				t.FailNow()
+			}
+			if !maps.Equal(result.UnwrapOrDefault(), tc.result.UnwrapOrDefault()) {
+				t.Fail()
+			}
+		})
+	}
+}
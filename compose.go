@@ -0,0 +1,77 @@
+package option
+
+// Compose returns a function that calls f and, if it returns
+// `Some`, calls g with the contained value. It is the function
+// equivalent of chaining AndThen calls, and the same as Pipe2.
+func Compose[A any, B any, C any](f func(A) Option[B], g func(B) Option[C]) func(A) Option[C] {
+	return Pipe2(f, g)
+}
+
+// Pipe returns a function that passes its input through each of
+// fs in order, stopping at the first `None`. With no functions,
+// it returns `Some` of its input.
+func Pipe[T any](fs ...func(T) Option[T]) func(T) Option[T] {
+	return func(t T) Option[T] {
+		o := Some(t)
+		for _, f := range fs {
+			o = AndThen(o, f)
+		}
+		return o
+	}
+}
+
+// Pipe2 returns a function that passes its input through
+// f1 to f2 in order, stopping at the first `None`.
+func Pipe2[A any, B any, C any](f1 func(A) Option[B], f2 func(B) Option[C]) func(A) Option[C] {
+	return func(a A) Option[C] {
+		return AndThen(f1(a), f2)
+	}
+}
+
+// Pipe3 returns a function that passes its input through
+// f1 to f3 in order, stopping at the first `None`.
+func Pipe3[A any, B any, C any, D any](f1 func(A) Option[B], f2 func(B) Option[C], f3 func(C) Option[D]) func(A) Option[D] {
+	return func(a A) Option[D] {
+		return AndThen(AndThen(f1(a), f2), f3)
+	}
+}
+
+// Pipe4 returns a function that passes its input through
+// f1 to f4 in order, stopping at the first `None`.
+func Pipe4[A any, B any, C any, D any, E any](f1 func(A) Option[B], f2 func(B) Option[C], f3 func(C) Option[D], f4 func(D) Option[E]) func(A) Option[E] {
+	return func(a A) Option[E] {
+		return AndThen(AndThen(AndThen(f1(a), f2), f3), f4)
+	}
+}
+
+// Pipe5 returns a function that passes its input through
+// f1 to f5 in order, stopping at the first `None`.
+func Pipe5[A any, B any, C any, D any, E any, F any](f1 func(A) Option[B], f2 func(B) Option[C], f3 func(C) Option[D], f4 func(D) Option[E], f5 func(E) Option[F]) func(A) Option[F] {
+	return func(a A) Option[F] {
+		return AndThen(AndThen(AndThen(AndThen(f1(a), f2), f3), f4), f5)
+	}
+}
+
+// Pipe6 returns a function that passes its input through
+// f1 to f6 in order, stopping at the first `None`.
+func Pipe6[A any, B any, C any, D any, E any, F any, G any](f1 func(A) Option[B], f2 func(B) Option[C], f3 func(C) Option[D], f4 func(D) Option[E], f5 func(E) Option[F], f6 func(F) Option[G]) func(A) Option[G] {
+	return func(a A) Option[G] {
+		return AndThen(AndThen(AndThen(AndThen(AndThen(f1(a), f2), f3), f4), f5), f6)
+	}
+}
+
+// Pipe7 returns a function that passes its input through
+// f1 to f7 in order, stopping at the first `None`.
+func Pipe7[A any, B any, C any, D any, E any, F any, G any, H any](f1 func(A) Option[B], f2 func(B) Option[C], f3 func(C) Option[D], f4 func(D) Option[E], f5 func(E) Option[F], f6 func(F) Option[G], f7 func(G) Option[H]) func(A) Option[H] {
+	return func(a A) Option[H] {
+		return AndThen(AndThen(AndThen(AndThen(AndThen(AndThen(f1(a), f2), f3), f4), f5), f6), f7)
+	}
+}
+
+// Pipe8 returns a function that passes its input through
+// f1 to f8 in order, stopping at the first `None`.
+func Pipe8[A any, B any, C any, D any, E any, F any, G any, H any, I any](f1 func(A) Option[B], f2 func(B) Option[C], f3 func(C) Option[D], f4 func(D) Option[E], f5 func(E) Option[F], f6 func(F) Option[G], f7 func(G) Option[H], f8 func(H) Option[I]) func(A) Option[I] {
+	return func(a A) Option[I] {
+		return AndThen(AndThen(AndThen(AndThen(AndThen(AndThen(AndThen(f1(a), f2), f3), f4), f5), f6), f7), f8)
+	}
+}
@@ -0,0 +1,108 @@
+package option_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func parseInt(s string) option.Option[int] {
+	return option.FromErr(strconv.Atoi(s))
+}
+
+func half(x int) option.Option[int] {
+	if x%2 != 0 {
+		return option.None[int]()
+	}
+	return option.Some(x / 2)
+}
+
+func toString(x int) option.Option[string] {
+	return option.Some(strconv.Itoa(x))
+}
+
+func TestCompose(t *testing.T) {
+	parseHalf := option.Compose(parseInt, half)
+	tests := map[string]struct {
+		input  string
+		result option.Option[int]
+	}{
+		"both_some": {
+			input:  "4",
+			result: option.Some(2),
+		},
+		"first_none": {
+			input:  "four",
+			result: option.None[int](),
+		},
+		"second_none": {
+			input:  "3",
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(parseHalf(tc.input), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestPipe(t *testing.T) {
+	tests := map[string]struct {
+		steps  []func(int) option.Option[int]
+		input  int
+		result option.Option[int]
+	}{
+		"no_steps": {
+			steps:  nil,
+			input:  3,
+			result: option.Some(3),
+		},
+		"all_some": {
+			steps:  []func(int) option.Option[int]{half, half},
+			input:  8,
+			result: option.Some(2),
+		},
+		"stops_at_none": {
+			steps:  []func(int) option.Option[int]{half, half, half},
+			input:  12,
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.Pipe(tc.steps...)(tc.input), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestPipeN(t *testing.T) {
+	tests := map[string]struct {
+		result   option.Option[string]
+		expected option.Option[string]
+	}{
+		"pipe2":      {result: option.Pipe2(parseInt, toString)("4"), expected: option.Some("4")},
+		"pipe2_none": {result: option.Pipe2(parseInt, toString)("x"), expected: option.None[string]()},
+		"pipe3":      {result: option.Pipe3(parseInt, half, toString)("4"), expected: option.Some("2")},
+		"pipe3_none": {result: option.Pipe3(parseInt, half, toString)("3"), expected: option.None[string]()},
+		"pipe4":      {result: option.Pipe4(parseInt, half, half, toString)("8"), expected: option.Some("2")},
+		"pipe5":      {result: option.Pipe5(parseInt, half, half, half, toString)("16"), expected: option.Some("2")},
+		"pipe6":      {result: option.Pipe6(parseInt, half, half, half, half, toString)("32"), expected: option.Some("2")},
+		"pipe7":      {result: option.Pipe7(parseInt, half, half, half, half, half, toString)("64"), expected: option.Some("2")},
+		"pipe8":      {result: option.Pipe8(parseInt, half, half, half, half, half, half, toString)("128"), expected: option.Some("2")},
+		"pipe8_none": {result: option.Pipe8(parseInt, half, half, half, half, half, half, toString)("96"), expected: option.None[string]()},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(tc.result, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
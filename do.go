@@ -0,0 +1,49 @@
+package option
+
+// Scope is the handle passed to a Do block. It is only valid
+// for the duration of the block it was passed to.
+type Scope struct {
+	done bool
+}
+
+// shortCircuit is the panic value used by Bind to abort a Do
+// block. It records its scope so that nested Do blocks only
+// recover their own short circuits, and the `None` that was
+// bound so that Do can return it with its origin.
+type shortCircuit struct {
+	scope *Scope
+	none  Option[struct{}]
+}
+
+// Do runs f and returns `Some` of its result. If a Bind inside
+// f is called with a `None`, f stops at that point and Do
+// returns that `None`, keeping its origin. Panics in f that
+// were not caused by Bind are re-raised unchanged.
+func Do[R any](f func(*Scope) R) (result Option[R]) {
+	s := &Scope{}
+	defer func() {
+		s.done = true
+		if r := recover(); r != nil {
+			if sc, ok := r.(shortCircuit); ok && sc.scope == s {
+				result = noneFrom[R](sc.none)
+				return
+			}
+			panic(r)
+		}
+	}()
+	return Some(f(s))
+}
+
+// Bind returns the contained value of o if it is `Some`.
+// Otherwise it aborts the Do block that s belongs to, which
+// then returns `None`. Bind must only be called from within
+// that block.
+func Bind[T any](s *Scope, o Option[T]) T {
+	if s.done {
+		panic("option: Bind called outside of its Do block")
+	}
+	if o.IsNone() {
+		panic(shortCircuit{scope: s, none: noneFrom[struct{}](o)})
+	}
+	return o.data
+}
@@ -0,0 +1,131 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestDo(t *testing.T) {
+	tests := map[string]struct {
+		x      option.Option[int]
+		y      option.Option[int]
+		steps  int
+		result option.Option[int]
+	}{
+		"some_some": {
+			x:      option.Some(1),
+			y:      option.Some(2),
+			steps:  2,
+			result: option.Some(3),
+		},
+		"some_none": {
+			x:      option.Some(1),
+			y:      option.None[int](),
+			steps:  1,
+			result: option.None[int](),
+		},
+		"none_some": {
+			x:      option.None[int](),
+			y:      option.Some(2),
+			steps:  0,
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			steps := 0
+			result := option.Do(func(b *option.Scope) int {
+				x := option.Bind(b, tc.x)
+				steps++
+				y := option.Bind(b, tc.y)
+				steps++
+				return x + y
+			})
+			if !equal(result, tc.result) {
+				t.Fail()
+			}
+			if steps != tc.steps {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestDoNested(t *testing.T) {
+	tests := map[string]struct {
+		inner  option.Option[int]
+		outer  option.Option[int]
+		result option.Option[option.Option[int]]
+	}{
+		"inner_scope_none": {
+			inner:  option.None[int](),
+			outer:  option.Some(1),
+			result: option.Some(option.None[int]()),
+		},
+		"outer_scope_none": {
+			inner:  option.Some(1),
+			outer:  option.None[int](),
+			result: option.None[option.Option[int]](),
+		},
+		"both_some": {
+			inner:  option.Some(1),
+			outer:  option.Some(2),
+			result: option.Some(option.Some(3)),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := option.Do(func(outer *option.Scope) option.Option[int] {
+				return option.Do(func(inner *option.Scope) int {
+					return option.Bind(inner, tc.inner) + option.Bind(outer, tc.outer)
+				})
+			})
+			if !equalFunc(result, tc.result, equal[int]) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestDoUnrelatedPanic(t *testing.T) {
+	defer func() {
+		if recover() != "unrelated" {
+			t.Fail()
+		}
+	}()
+	option.Do(func(b *option.Scope) int {
+		panic("unrelated")
+	})
+	t.Fail()
+}
+func TestBindOutsideDo(t *testing.T) {
+	var escaped *option.Scope
+	option.Do(func(b *option.Scope) int {
+		escaped = b
+		return 0
+	})
+	defer func() {
+		if recover() == nil {
+			t.Fail()
+		}
+	}()
+	option.Bind(escaped, option.Some(1))
+	t.Fail()
+}
+func BenchmarkDo(b *testing.B) {
+	x, y := option.Some(1), option.Some(2)
+	for i := 0; i < b.N; i++ {
+		option.Do(func(s *option.Scope) int {
+			return option.Bind(s, x) + option.Bind(s, y)
+		})
+	}
+}
+func BenchmarkAndThen(b *testing.B) {
+	x, y := option.Some(1), option.Some(2)
+	for i := 0; i < b.N; i++ {
+		option.AndThen(x, func(a int) option.Option[int] {
+			return option.Map(y, func(b int) int { return a + b })
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+)
+
+// ErrNone is the error reported when a value is requested
+// from a `None` option.
+var ErrNone = errors.New("option: no value")
+
+// NoneError is the value Unwrap and Expect panic with when
+// called on a `None` option. It wraps ErrNone, so a recovered
+// NoneError can be detected with errors.Is.
+type NoneError struct {
+	// Type is the element type T of the Option[T].
+	Type reflect.Type
+	// Message is the message passed to Expect, or a
+	// default message for Unwrap.
+	Message string
+	// Reason is the reason attached to a Reasoned `None`, if any.
+	Reason string
+	// Caller is the frame that called Unwrap or Expect.
+	Caller runtime.Frame
+	// Origin is the stack at which the `None` was created. It
+	// is only recorded in builds with the optiondebug tag, and
+	// is empty otherwise.
+	Origin string
+}
+
+// newNoneError must be called directly from Unwrap or Expect
+// so that the caller can be found.
+func newNoneError[T any](o Option[T], msg, reason string) *NoneError {
+	pcs := make([]uintptr, 1)
+	// Skip runtime.Callers, newNoneError and Unwrap or Expect.
+	runtime.Callers(3, pcs)
+	caller, _ := runtime.CallersFrames(pcs).Next()
+	return &NoneError{
+		Type:    reflect.TypeFor[T](),
+		Message: msg,
+		Reason:  reason,
+		Caller:  caller,
+		Origin:  o.origin.String(),
+	}
+}
+
+// Error returns the message followed by the type of the option,
+// the reason for the `None` and its origin if they were recorded.
+func (e *NoneError) Error() string {
+	msg := fmt.Sprintf("%s (Option[%s])", e.Message, e.Type)
+	if e.Reason != "" {
+		msg = fmt.Sprintf("%s (Option[%s]: %s)", e.Message, e.Type, e.Reason)
+	}
+	if e.Origin != "" {
+		msg += "\nNone created at:\n" + e.Origin
+	}
+	return msg
+}
+
+// Unwrap returns ErrNone.
+func (e *NoneError) Unwrap() error {
+	return ErrNone
+}
@@ -0,0 +1,42 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestNoneErrorError(t *testing.T) {
+	tests := map[string]struct {
+		fn       func()
+		expected string
+	}{
+		"unwrap": {
+			fn:       func() { option.None[int]().Unwrap() },
+			expected: "No value in Option (Option[int])",
+		},
+		"expect": {
+			fn:       func() { option.None[string]().Expect("missing name") },
+			expected: "missing name (Option[string])",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(*option.NoneError)
+				if !ok {
+					t.FailNow()
+				}
+				expected := tc.expected
+				if err.Origin != "" {
+					expected += "\nNone created at:\n" + err.Origin
+				}
+				if err.Error() != expected {
+					t.Fail()
+				}
+			}()
+			tc.fn()
+		})
+	}
+}
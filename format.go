@@ -0,0 +1,45 @@
+package option
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// String implements fmt.Stringer, returning `Some(v)` with the
+// default format of the contained value, or `None`.
+func (o Option[T]) String() string {
+	if o.IsNone() {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.data)
+}
+
+// GoString implements fmt.GoStringer, returning the Go syntax
+// that constructs the option, such as `option.Some[int](5)`
+// or `option.None[int]()`.
+func (o Option[T]) GoString() string {
+	typ := reflect.TypeFor[T]().String()
+	if o.IsNone() {
+		return fmt.Sprintf("option.None[%s]()", typ)
+	}
+	return fmt.Sprintf("option.Some[%s](%#v)", typ, o.data)
+}
+
+// Format implements fmt.Formatter. The verb, flags, width and
+// precision are applied to the contained value, which is printed
+// as `Some(v)`. A `None` value always prints as `None`, and the
+// `%#v` verb prints the same as GoString.
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, o.GoString())
+		return
+	}
+	if o.IsNone() {
+		io.WriteString(f, "None")
+		return
+	}
+	io.WriteString(f, "Some(")
+	fmt.Fprintf(f, fmt.FormatString(f, verb), o.data)
+	io.WriteString(f, ")")
+}
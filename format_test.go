@@ -0,0 +1,138 @@
+package option_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestString(t *testing.T) {
+	tests := map[string]struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		"some_value": {
+			value:    option.Some(5),
+			expected: "Some(5)",
+		},
+		"some_string": {
+			value:    option.Some("a"),
+			expected: "Some(a)",
+		},
+		"some_some": {
+			value:    option.Some(option.Some(5)),
+			expected: "Some(Some(5))",
+		},
+		"no_value": {
+			value:    option.None[int](),
+			expected: "None",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.String() != tc.expected {
+				t.Errorf("got %s, want %s", tc.value.String(), tc.expected)
+			}
+		})
+	}
+}
+func TestGoString(t *testing.T) {
+	tests := map[string]struct {
+		value    fmt.GoStringer
+		expected string
+	}{
+		"some_value": {
+			value:    option.Some(5),
+			expected: "option.Some[int](5)",
+		},
+		"some_string": {
+			value:    option.Some("a"),
+			expected: `option.Some[string]("a")`,
+		},
+		"no_value": {
+			value:    option.None[int](),
+			expected: "option.None[int]()",
+		},
+		"no_value_interface": {
+			value:    option.None[error](),
+			expected: "option.None[error]()",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.GoString() != tc.expected {
+				t.Errorf("got %s, want %s", tc.value.GoString(), tc.expected)
+			}
+		})
+	}
+}
+func TestFormat(t *testing.T) {
+	tests := map[string]struct {
+		format   string
+		value    any
+		expected string
+	}{
+		"v_some": {
+			format:   "%v",
+			value:    option.Some(5),
+			expected: "Some(5)",
+		},
+		"v_none": {
+			format:   "%v",
+			value:    option.None[int](),
+			expected: "None",
+		},
+		"sharp_v_some": {
+			format:   "%#v",
+			value:    option.Some(5),
+			expected: "option.Some[int](5)",
+		},
+		"sharp_v_none": {
+			format:   "%#v",
+			value:    option.None[string](),
+			expected: "option.None[string]()",
+		},
+		"d_some": {
+			format:   "%03d",
+			value:    option.Some(5),
+			expected: "Some(005)",
+		},
+		"d_none": {
+			format:   "%d",
+			value:    option.None[int](),
+			expected: "None",
+		},
+		"q_some": {
+			format:   "%q",
+			value:    option.Some("a"),
+			expected: `Some("a")`,
+		},
+		"x_some": {
+			format:   "%x",
+			value:    option.Some(255),
+			expected: "Some(ff)",
+		},
+		"struct_field": {
+			format:   "%v",
+			value:    struct{ A option.Option[int] }{A: option.Some(1)},
+			expected: "{Some(1)}",
+		},
+		"slice": {
+			format:   "%v",
+			value:    []option.Option[int]{option.Some(1), option.None[int]()},
+			expected: "[Some(1) None]",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			formatted := fmt.Sprintf(tc.format, tc.value)
+			if formatted != tc.expected {
+				t.Errorf("got %s, want %s", formatted, tc.expected)
+			}
+		})
+	}
+}
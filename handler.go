@@ -0,0 +1,81 @@
+package option
+
+import (
+	"reflect"
+	"runtime"
+	"sync/atomic"
+)
+
+// UnwrapHandler is called by Unwrap and Expect with the error
+// they are about to panic with when called on a `None` option.
+// It can be used to log the error or record a metric. If the
+// handler panics itself, that panic replaces the default one.
+// The handler is not called again while it is running on the
+// same goroutine, so a `None` it unwraps itself just panics.
+type UnwrapHandler func(err *NoneError)
+
+var unwrapHandler atomic.Pointer[UnwrapHandler]
+
+// SetUnwrapHandler installs h as the process-wide UnwrapHandler
+// and returns the previously installed handler. Passing nil
+// removes the handler.
+func SetUnwrapHandler(h UnwrapHandler) UnwrapHandler {
+	var old *UnwrapHandler
+	if h == nil {
+		old = unwrapHandler.Swap(nil)
+	} else {
+		old = unwrapHandler.Swap(&h)
+	}
+	if old == nil {
+		return nil
+	}
+	return *old
+}
+
+// WithUnwrapHandler installs h, calls f, then restores the
+// previous handler, even if f panics. Since the handler is
+// process-wide, it should not be used from parallel tests.
+func WithUnwrapHandler(h UnwrapHandler, f func()) {
+	old := SetUnwrapHandler(h)
+	defer SetUnwrapHandler(old)
+	f()
+}
+
+// panicNone calls the installed UnwrapHandler, if any, unless
+// it is already running on this goroutine, then panics with err.
+func panicNone(err *NoneError) {
+	if h := unwrapHandler.Load(); h != nil && !inHandler() {
+		callHandler(*h, err)
+	}
+	panic(err)
+}
+
+// callHandler calls h. It is never inlined, so that inHandler
+// can find it on the stack.
+//
+//go:noinline
+func callHandler(h UnwrapHandler, err *NoneError) {
+	h(err)
+}
+
+var callHandlerName = runtime.FuncForPC(reflect.ValueOf(callHandler).Pointer()).Name()
+
+// inHandler reports whether callHandler is on the stack of the
+// current goroutine.
+func inHandler() bool {
+	pcs := make([]uintptr, 64)
+	// Skip runtime.Callers, inHandler and panicNone.
+	for runtime.Callers(3, pcs) == len(pcs) {
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Function == callHandlerName {
+			return true
+		}
+		if !more {
+			return false
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package option_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+type customPanic struct {
+	msg string
+}
+
+func TestWithUnwrapHandler(t *testing.T) {
+	tests := map[string]struct {
+		fn  func()
+		msg string
+	}{
+		"unwrap": {
+			fn:  func() { option.None[int]().Unwrap() },
+			msg: "No value in Option",
+		},
+		"expect": {
+			fn:  func() { option.None[int]().Expect("missing") },
+			msg: "missing",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var handled *option.NoneError
+			var recovered any
+			option.WithUnwrapHandler(func(err *option.NoneError) { handled = err }, func() {
+				defer func() { recovered = recover() }()
+				tc.fn()
+			})
+			if handled == nil || handled.Message != tc.msg {
+				t.FailNow()
+			}
+			if recovered != handled {
+				t.Fail()
+			}
+			if !strings.HasSuffix(handled.Caller.File, "handler_test.go") {
+				t.Errorf("unexpected caller %s", handled.Caller.File)
+			}
+		})
+	}
+}
+func TestUnwrapHandlerCustomPanic(t *testing.T) {
+	var recovered any
+	option.WithUnwrapHandler(func(err *option.NoneError) { panic(customPanic{msg: err.Message}) }, func() {
+		defer func() { recovered = recover() }()
+		option.None[int]().Expect("custom")
+	})
+	if recovered != (customPanic{msg: "custom"}) {
+		t.Fail()
+	}
+}
+func TestUnwrapHandlerNotCalledForSome(t *testing.T) {
+	calls := 0
+	option.WithUnwrapHandler(func(*option.NoneError) { calls++ }, func() {
+		option.Some(1).Unwrap()
+		option.Some(1).Expect("unused")
+	})
+	if calls != 0 {
+		t.Fail()
+	}
+}
+func TestSetUnwrapHandler(t *testing.T) {
+	calls := 0
+	old := option.SetUnwrapHandler(func(*option.NoneError) { calls++ })
+	if old != nil {
+		t.Fail()
+	}
+	option.Catch(option.None[int]().Unwrap)
+	if calls != 1 {
+		t.Fail()
+	}
+
+	restored := option.SetUnwrapHandler(old)
+	if restored == nil {
+		t.Fail()
+	}
+	option.Catch(option.None[int]().Unwrap)
+	if calls != 1 {
+		t.Fail()
+	}
+}
+func TestWithUnwrapHandlerRestores(t *testing.T) {
+	outerCalls, innerCalls := 0, 0
+	option.WithUnwrapHandler(func(*option.NoneError) { outerCalls++ }, func() {
+		option.WithUnwrapHandler(func(*option.NoneError) { innerCalls++ }, func() {
+			option.Catch(option.None[int]().Unwrap)
+		})
+		option.Catch(option.None[int]().Unwrap)
+	})
+	option.Catch(option.None[int]().Unwrap)
+	if outerCalls != 1 || innerCalls != 1 {
+		t.Fail()
+	}
+}
+func TestUnwrapHandlerReentrant(t *testing.T) {
+	calls := 0
+	var recovered any
+	option.WithUnwrapHandler(func(err *option.NoneError) {
+		calls++
+		option.None[string]().Expect("inside handler")
+	}, func() {
+		defer func() { recovered = recover() }()
+		option.None[int]().Expect("outside handler")
+	})
+	if calls != 1 {
+		t.Fail()
+	}
+	err, ok := recovered.(*option.NoneError)
+	if !ok || err.Message != "inside handler" {
+		t.Fail()
+	}
+	// The handler runs again for the next None.
+	option.WithUnwrapHandler(func(*option.NoneError) { calls++ }, func() {
+		option.Catch(option.None[int]().Unwrap)
+	})
+	if calls != 2 {
+		t.Fail()
+	}
+}
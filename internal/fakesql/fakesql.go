@@ -0,0 +1,113 @@
+// Package fakesql provides an in-memory database/sql driver for
+// testing. Every query echoes its arguments back as a single row
+// with one column per argument. A query with no arguments returns
+// no rows, and the query ErrorQuery always fails with ErrQuery.
+package fakesql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DriverName is the name the driver is registered under.
+const DriverName = "optionfake"
+
+// ErrorQuery is a query that always fails with ErrQuery.
+const ErrorQuery = "ERROR"
+
+// ErrQuery is returned when ErrorQuery is executed.
+var ErrQuery = errors.New("fakesql: query failed")
+
+func init() {
+	sql.Register(DriverName, fakeDriver{})
+}
+
+// Open opens a new database handle using the fake driver.
+func Open() (*sql.DB, error) {
+	return sql.Open(DriverName, "")
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{query: query}, nil
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error {
+	return nil
+}
+
+func (fakeTx) Rollback() error {
+	return nil
+}
+
+type fakeStmt struct {
+	query string
+}
+
+func (fakeStmt) Close() error {
+	return nil
+}
+
+func (fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query == ErrorQuery {
+		return nil, ErrQuery
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query == ErrorQuery {
+		return nil, ErrQuery
+	}
+	return &fakeRows{values: args}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *fakeRows) Columns() []string {
+	columns := make([]string, len(r.values))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%d", i)
+	}
+	return columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done || len(r.values) == 0 {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
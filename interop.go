@@ -0,0 +1,58 @@
+package option
+
+// FromOk returns `Some(data)` if ok is true, otherwise `None`.
+// It converts the result of a comma-ok expression such as a
+// map lookup or type assertion into an Option.
+func FromOk[T any](data T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(data)
+}
+
+// FromErr returns `Some(data)` if err is nil, otherwise `None`.
+// The error is discarded; use `Err` and `Ok` to keep it.
+func FromErr[T any](data T, err error) Option[T] {
+	if err != nil {
+		return None[T]()
+	}
+	return Some(data)
+}
+
+// FromPtr returns `None` if p is nil, otherwise `Some` with
+// a copy of the value p points to.
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// Get returns the contained value and `true` if the option
+// is a `Some` value, otherwise the zero value of type T
+// and `false`.
+func (o Option[T]) Get() (T, bool) {
+	if o.IsNone() {
+		var t T
+		return t, false
+	}
+	return o.data, true
+}
+
+// ToPtr returns a pointer to a copy of the contained value,
+// or nil if the option is `None`.
+func (o Option[T]) ToPtr() *T {
+	if o.IsNone() {
+		return nil
+	}
+	t := o.data
+	return &t
+}
+
+// Get returns the contained value and error as a Go-style
+// `(T, error)` pair. Combined with OkOr, it converts an
+// option into `(T, error)`: `o.OkOr(err).Get()`, which
+// returns ErrNone for a `None` if err is nil.
+func (r Result[T]) Get() (T, error) {
+	return r.data, r.err
+}
@@ -0,0 +1,175 @@
+package option_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestFromOk(t *testing.T) {
+	m := map[string]int{"one": 1}
+	tests := map[string]struct {
+		key    string
+		result option.Option[int]
+	}{
+		"present": {
+			key:    "one",
+			result: option.Some(1),
+		},
+		"missing": {
+			key:    "two",
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			v, ok := m[tc.key]
+			if !equal(option.FromOk(v, ok), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestFromErr(t *testing.T) {
+	tests := map[string]struct {
+		data   int
+		err    error
+		result option.Option[int]
+	}{
+		"nil_error": {
+			data:   1,
+			err:    nil,
+			result: option.Some(1),
+		},
+		"error": {
+			data:   1,
+			err:    errors.New("failed"),
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.FromErr(tc.data, tc.err), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestFromPtr(t *testing.T) {
+	x := 1
+	tests := map[string]struct {
+		ptr    *int
+		result option.Option[int]
+	}{
+		"non_nil": {
+			ptr:    &x,
+			result: option.Some(1),
+		},
+		"nil": {
+			ptr:    nil,
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			o := option.FromPtr(tc.ptr)
+			if !equal(o, tc.result) {
+				t.Fail()
+			}
+			x = 2
+			if !equal(o, tc.result) {
+				t.Fail()
+			}
+			x = 1
+		})
+	}
+}
+func TestGet(t *testing.T) {
+	tests := map[string]struct {
+		value option.Option[int]
+		inner int
+		ok    bool
+	}{
+		"some_value": {
+			value: option.Some(1),
+			inner: 1,
+			ok:    true,
+		},
+		"no_value": {
+			value: option.None[int](),
+			inner: 0,
+			ok:    false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			inner, ok := tc.value.Get()
+			if inner != tc.inner || ok != tc.ok {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestToPtr(t *testing.T) {
+	tests := map[string]struct {
+		value option.Option[int]
+		isNil bool
+	}{
+		"some_value": {
+			value: option.Some(1),
+			isNil: false,
+		},
+		"no_value": {
+			value: option.None[int](),
+			isNil: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			p := tc.value.ToPtr()
+			if (p == nil) != tc.isNil {
+				t.FailNow()
+			}
+			if p != nil {
+				*p = *p + 1
+				if option.FromPtr(p) == tc.value {
+					t.Fail()
+				}
+			}
+		})
+	}
+}
+func TestResultGet(t *testing.T) {
+	errMissing := errors.New("missing")
+	tests := map[string]struct {
+		value option.Option[int]
+		inner int
+		err   error
+	}{
+		"some_value": {
+			value: option.Some(1),
+			inner: 1,
+			err:   nil,
+		},
+		"no_value": {
+			value: option.None[int](),
+			inner: 0,
+			err:   errMissing,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			inner, err := tc.value.OkOr(errMissing).Get()
+			if inner != tc.inner || err != tc.err {
+				t.Fail()
+			}
+		})
+	}
+}
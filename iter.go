@@ -0,0 +1,59 @@
+package option
+
+import "iter"
+
+// All returns an iterator over the contained value, yielding
+// it once if the option is `Some` and nothing if it is `None`.
+func (o Option[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.IsSome() {
+			yield(o.data)
+		}
+	}
+}
+
+// FilterSome returns an iterator over the contained values of
+// the `Some` options in seq, skipping every `None`.
+func FilterSome[T any](seq iter.Seq[Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if o.IsSome() && !yield(o.data) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMap returns an iterator that applies f to each value
+// in seq and yields the contained value of each `Some` result,
+// skipping every `None`.
+func FilterMap[T any, U any](seq iter.Seq[T], f func(T) Option[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for t := range seq {
+			if o := f(t); o.IsSome() && !yield(o.data) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhileSome returns an iterator over the contained values
+// of the options in seq, stopping at the first `None`.
+func TakeWhileSome[T any](seq iter.Seq[Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if o.IsNone() || !yield(o.data) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first value of seq, or `None` if
+// seq is empty.
+func First[T any](seq iter.Seq[T]) Option[T] {
+	for t := range seq {
+		return Some(t)
+	}
+	return None[T]()
+}
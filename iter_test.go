@@ -0,0 +1,162 @@
+package option_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestAll(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		result []int
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			result: []int{1},
+		},
+		"no_value": {
+			value:  option.None[int](),
+			result: nil,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var result []int
+			for v := range tc.value.All() {
+				result = append(result, v)
+			}
+			if !slices.Equal(result, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestFilterSome(t *testing.T) {
+	tests := map[string]struct {
+		values []option.Option[int]
+		result []int
+	}{
+		"all_some": {
+			values: []option.Option[int]{option.Some(1), option.Some(2)},
+			result: []int{1, 2},
+		},
+		"mixed": {
+			values: []option.Option[int]{option.None[int](), option.Some(1), option.None[int](), option.Some(2)},
+			result: []int{1, 2},
+		},
+		"all_none": {
+			values: []option.Option[int]{option.None[int](), option.None[int]()},
+			result: nil,
+		},
+		"empty": {
+			values: nil,
+			result: nil,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := slices.Collect(option.FilterSome(slices.Values(tc.values)))
+			if !slices.Equal(result, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestFilterSomeBreak(t *testing.T) {
+	values := []option.Option[int]{option.Some(1), option.None[int](), option.Some(2), option.Some(3)}
+	var result []int
+	for v := range option.FilterSome(slices.Values(values)) {
+		result = append(result, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(result, []int{1, 2}) {
+		t.Fail()
+	}
+}
+func TestFilterMap(t *testing.T) {
+	evenHalf := func(x int) option.Option[int] {
+		if x%2 != 0 {
+			return option.None[int]()
+		}
+		return option.Some(x / 2)
+	}
+	tests := map[string]struct {
+		values []int
+		result []int
+	}{
+		"mixed": {
+			values: []int{1, 2, 3, 4},
+			result: []int{1, 2},
+		},
+		"all_none": {
+			values: []int{1, 3},
+			result: nil,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := slices.Collect(option.FilterMap(slices.Values(tc.values), evenHalf))
+			if !slices.Equal(result, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestTakeWhileSome(t *testing.T) {
+	tests := map[string]struct {
+		values []option.Option[int]
+		result []int
+	}{
+		"all_some": {
+			values: []option.Option[int]{option.Some(1), option.Some(2)},
+			result: []int{1, 2},
+		},
+		"stops_at_none": {
+			values: []option.Option[int]{option.Some(1), option.None[int](), option.Some(2)},
+			result: []int{1},
+		},
+		"starts_with_none": {
+			values: []option.Option[int]{option.None[int](), option.Some(1)},
+			result: nil,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := slices.Collect(option.TakeWhileSome(slices.Values(tc.values)))
+			if !slices.Equal(result, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestFirst(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		result option.Option[int]
+	}{
+		"non_empty": {
+			values: []int{3, 2, 1},
+			result: option.Some(3),
+		},
+		"empty": {
+			values: nil,
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.First(slices.Values(tc.values)), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler. A `None` value is
+// encoded as `null`, and a `Some` value is encoded as the JSON
+// encoding of the contained value.
+//
+// Note that `Some(None)` of a nested Option is also encoded
+// as `null`, and so decodes back to `None`.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if o.IsNone() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON `null`
+// decodes to `None`, and any other value is decoded into
+// type T and wrapped in `Some`.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	*o = Some(t)
+	return nil
+}
+
+// IsZero reports whether the option is a `None` value. This
+// allows struct fields of type Option tagged with `omitzero`
+// to be left out of the JSON encoding entirely when `None`.
+func (o Option[T]) IsZero() bool {
+	return o.IsNone()
+}
@@ -0,0 +1,179 @@
+package option_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+type jsonRecord struct {
+	Name option.Option[string] `json:"name"`
+	Age  option.Option[int]    `json:"age"`
+}
+
+type jsonPatch struct {
+	Name option.Option[string] `json:"name,omitzero"`
+	Age  option.Option[int]    `json:"age,omitempty,omitzero"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		value    any
+		expected string
+	}{
+		"some_value": {
+			value:    option.Some(1),
+			expected: `1`,
+		},
+		"no_value": {
+			value:    option.None[int](),
+			expected: `null`,
+		},
+		"some_string": {
+			value:    option.Some("hello"),
+			expected: `"hello"`,
+		},
+		"some_some": {
+			value:    option.Some(option.Some(1)),
+			expected: `1`,
+		},
+		"some_none": {
+			value:    option.Some(option.None[int]()),
+			expected: `null`,
+		},
+		"struct_fields": {
+			value:    jsonRecord{Name: option.Some("a"), Age: option.None[int]()},
+			expected: `{"name":"a","age":null}`,
+		},
+		"slice": {
+			value:    []option.Option[int]{option.Some(1), option.None[int](), option.Some(3)},
+			expected: `[1,null,3]`,
+		},
+		"omitzero_none": {
+			value:    jsonPatch{Name: option.None[string](), Age: option.None[int]()},
+			expected: `{}`,
+		},
+		"omitzero_some": {
+			value:    jsonPatch{Name: option.Some(""), Age: option.Some(0)},
+			expected: `{"name":"","age":0}`,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			data, err := json.Marshal(tc.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tc.expected {
+				t.Errorf("got %s, want %s", data, tc.expected)
+			}
+		})
+	}
+}
+func TestUnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data   string
+		target func() any
+		result func(any) bool
+	}{
+		"some_value": {
+			data:   `1`,
+			target: func() any { return new(option.Option[int]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[int]), option.Some(1)) },
+		},
+		"no_value": {
+			data:   `null`,
+			target: func() any { return new(option.Option[int]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[int]), option.None[int]()) },
+		},
+		"some_some": {
+			data:   `1`,
+			target: func() any { return new(option.Option[option.Option[int]]) },
+			result: func(v any) bool {
+				return equalFunc(*v.(*option.Option[option.Option[int]]), option.Some(option.Some(1)), equal[int])
+			},
+		},
+		"nested_null": {
+			data:   `null`,
+			target: func() any { return new(option.Option[option.Option[int]]) },
+			result: func(v any) bool {
+				return equalFunc(*v.(*option.Option[option.Option[int]]), option.None[option.Option[int]](), equal[int])
+			},
+		},
+		"struct_fields": {
+			data:   `{"name":"a","age":null}`,
+			target: func() any { return new(jsonRecord) },
+			result: func(v any) bool {
+				r := v.(*jsonRecord)
+				return equal(r.Name, option.Some("a")) && equal(r.Age, option.None[int]())
+			},
+		},
+		"struct_missing_field": {
+			data:   `{"age":3}`,
+			target: func() any { return new(jsonRecord) },
+			result: func(v any) bool {
+				r := v.(*jsonRecord)
+				return equal(r.Name, option.None[string]()) && equal(r.Age, option.Some(3))
+			},
+		},
+		"slice": {
+			data:   `[1,null,3]`,
+			target: func() any { return new([]option.Option[int]) },
+			result: func(v any) bool {
+				return slices.EqualFunc(*v.(*[]option.Option[int]), []option.Option[int]{option.Some(1), option.None[int](), option.Some(3)}, equal[int])
+			},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			target := tc.target()
+			if err := json.Unmarshal([]byte(tc.data), target); err != nil {
+				t.Fatal(err)
+			}
+			if !tc.result(target) {
+				t.Errorf("got %v", reflect.ValueOf(target).Elem().Interface())
+			}
+		})
+	}
+}
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	var o option.Option[int]
+	if err := json.Unmarshal([]byte(`"one"`), &o); err == nil {
+		t.Fail()
+	}
+	if !equal(o, option.None[int]()) {
+		t.Fail()
+	}
+}
+func TestIsZero(t *testing.T) {
+	tests := map[string]struct {
+		value    option.Option[int]
+		expected bool
+	}{
+		"some_value": {
+			value:    option.Some(1),
+			expected: false,
+		},
+		"some_zero_value": {
+			value:    option.Some(0),
+			expected: false,
+		},
+		"no_value": {
+			value:    option.None[int](),
+			expected: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.IsZero() != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
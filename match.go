@@ -0,0 +1,23 @@
+package option
+
+// Match calls onSome with the contained value if the option is
+// `Some`, otherwise calls onNone, and returns the result. Both
+// cases must be handled, so the value is never unwrapped
+// without a check.
+func Match[T any, U any](o Option[T], onSome func(T) U, onNone func() U) U {
+	if o.IsNone() {
+		return onNone()
+	}
+	return onSome(o.data)
+}
+
+// Switch calls onSome with the contained value if the option is
+// `Some`, otherwise calls onNone. It is the side effect form of
+// Match.
+func (o Option[T]) Switch(onSome func(T), onNone func()) {
+	if o.IsNone() {
+		onNone()
+		return
+	}
+	onSome(o.data)
+}
@@ -0,0 +1,70 @@
+package option_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestMatch(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		result string
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			result: "1",
+		},
+		"no_value": {
+			value:  option.None[int](),
+			result: "none",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := option.Match(tc.value, strconv.Itoa, func() string { return "none" })
+			if result != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestSwitch(t *testing.T) {
+	tests := map[string]struct {
+		value     option.Option[int]
+		someCalls int
+		noneCalls int
+		inner     int
+	}{
+		"some_value": {
+			value:     option.Some(1),
+			someCalls: 1,
+			noneCalls: 0,
+			inner:     1,
+		},
+		"no_value": {
+			value:     option.None[int](),
+			someCalls: 0,
+			noneCalls: 1,
+			inner:     0,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			someCalls, noneCalls, inner := 0, 0, 0
+			tc.value.Switch(
+				func(x int) {
+					someCalls++
+					inner = x
+				},
+				func() { noneCalls++ },
+			)
+			if someCalls != tc.someCalls || noneCalls != tc.noneCalls || inner != tc.inner {
+				t.Fail()
+			}
+		})
+	}
+}
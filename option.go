@@ -1,6 +1,7 @@
 package option
 
 type Option[T any] struct {
+	origin   origin
 	data     T
 	has_data bool
 }
@@ -17,11 +18,21 @@ func Some[T any](data T) Option[T] {
 func None[T any]() Option[T] {
 	var t T
 	return Option[T]{
+		origin:   newOrigin(),
 		data:     t,
 		has_data: false,
 	}
 }
 
+// noneFrom returns an Option of type U with no value which
+// keeps the origin of o. It is used by functions that pass on
+// a `None` they were given.
+func noneFrom[U any, T any](o Option[T]) Option[U] {
+	return Option[U]{
+		origin: o.origin,
+	}
+}
+
 // IsSome returns `true` if the option is a `Some` value.
 func (o Option[T]) IsSome() bool {
 	return o.has_data
@@ -39,23 +50,37 @@ func (o Option[T]) IsNone() bool {
 }
 
 // Expect returns the contained `Some` value unsafely.
-// Panics with the given message if `None`.
+// Panics with a *NoneError holding the given message if `None`,
+// after calling the UnwrapHandler if one is installed.
 func (o Option[T]) Expect(msg string) T {
 	if o.IsNone() {
-		panic(msg)
+		panicNone(newNoneError(o, msg, ""))
 	}
 	return o.data
 }
 
 // Unwrap returns the contained `Some` value unsafely.
-// Panics if `None`.
+// Panics with a *NoneError if `None`, after calling the
+// UnwrapHandler if one is installed.
 func (o Option[T]) Unwrap() T {
 	if o.IsNone() {
-		panic("No value in Option")
+		panicNone(newNoneError(o, "No value in Option", ""))
 	}
 	return o.data
 }
 
+// UnwrapErr returns the contained `Some` value, or
+// the zero value of type T and ErrNone if `None`.
+// It never panics, and is the same as `o.OkOr(ErrNone).Get()`.
+// To get the error out of a failed Result, use Result.ErrValue.
+func (o Option[T]) UnwrapErr() (T, error) {
+	if o.IsNone() {
+		var t T
+		return t, ErrNone
+	}
+	return o.data, nil
+}
+
 // UnwrapOr returns the contained `Some` value or
 // a provided default.
 func (o Option[T]) UnwrapOr(fallback T) T {
@@ -88,7 +113,7 @@ func (o Option[T]) UnwrapOrDefault() T {
 // to the contained value if it exists.
 func Map[T any, U any](o Option[T], f func(T) U) Option[U] {
 	if o.IsNone() {
-		return None[U]()
+		return noneFrom[U](o)
 	}
 	return Some(f(o.data))
 }
@@ -123,7 +148,7 @@ func MapOrElse[T any, U any](o Option[T], fallbackFn func() U, f func(T) U) U {
 // And returns None if the option is None, otherwise returns optb.
 func And[T any, U any](o Option[T], optB Option[U]) Option[U] {
 	if o.IsNone() {
-		return None[U]()
+		return noneFrom[U](o)
 	}
 	return optB
 }
@@ -133,7 +158,7 @@ func And[T any, U any](o Option[T], optB Option[U]) Option[U] {
 // Also known as flatmap or monadic bind.
 func AndThen[T any, U any](o Option[T], f func(T) Option[U]) Option[U] {
 	if o.IsNone() {
-		return None[U]()
+		return noneFrom[U](o)
 	}
 	return f(o.data)
 }
@@ -143,7 +168,10 @@ func AndThen[T any, U any](o Option[T], f func(T) Option[U]) Option[U] {
 // - Some(t) if predicate returns true (where t is the wrapped value), and
 // - None if predicate returns false.
 func (o Option[T]) Filter(f func(T) bool) Option[T] {
-	if o.IsNone() || !f(o.data) {
+	if o.IsNone() {
+		return o
+	}
+	if !f(o.data) {
 		return None[T]()
 	}
 	return o
@@ -252,7 +280,7 @@ func (o Option[T]) Copy() Option[T] {
 // Flatten converts from `Option[Option[T]]` to `Option[T]`.
 func Flatten[T any](o Option[Option[T]]) Option[T] {
 	if o.IsNone() {
-		return None[T]()
+		return noneFrom[T](o)
 	}
 	return o.data
 }
@@ -1,11 +1,28 @@
 package option_test
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/JustinKnueppel/go-option"
 )
 
+// equal reports whether a and b are both `None`, or both `Some`
+// with equal values. Unlike ==, it ignores the origin that a `None`
+// records in builds with the optiondebug tag, so the tests can run
+// in both builds.
+func equal[T comparable](a, b option.Option[T]) bool {
+	return equalFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// equalFunc is like equal, but compares the values with eq.
+func equalFunc[T any](a, b option.Option[T], eq func(T, T) bool) bool {
+	x, okA := a.Get()
+	y, okB := b.Get()
+	return okA == okB && (!okA || eq(x, y))
+}
+
 func TestIsSome(t *testing.T) {
 	tests := map[string]struct {
 		value    option.Option[int]
@@ -107,8 +124,18 @@ func TestExpect(t *testing.T) {
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
 			defer func() {
-				panicMsg := recover()
-				if tc.expectedError && panicMsg != tc.msg {
+				e := recover()
+				if !tc.expectedError {
+					if e != nil {
+						t.Fail()
+					}
+					return
+				}
+				noneErr, ok := e.(*option.NoneError)
+				if !ok || noneErr.Message != tc.msg || noneErr.Type != reflect.TypeFor[int]() {
+					t.Fail()
+				}
+				if !errors.Is(noneErr, option.ErrNone) {
 					t.Fail()
 				}
 			}()
@@ -144,6 +171,12 @@ func TestUnwrap(t *testing.T) {
 				if !tc.expectedError && e != nil {
 					t.Fail()
 				}
+				if tc.expectedError {
+					err, ok := e.(error)
+					if !ok || !errors.Is(err, option.ErrNone) {
+						t.Fail()
+					}
+				}
 			}()
 			val := tc.value.Unwrap()
 			if val != tc.inner {
@@ -152,6 +185,33 @@ func TestUnwrap(t *testing.T) {
 		})
 	}
 }
+func TestUnwrapErr(t *testing.T) {
+	tests := map[string]struct {
+		value option.Option[int]
+		inner int
+		err   error
+	}{
+		"some_value": {
+			value: option.Some(1),
+			inner: 1,
+			err:   nil,
+		},
+		"no_value": {
+			value: option.None[int](),
+			inner: 0,
+			err:   option.ErrNone,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			val, err := tc.value.UnwrapErr()
+			if val != tc.inner || err != tc.err {
+				t.Fail()
+			}
+		})
+	}
+}
 func TestUnwrapOr(t *testing.T) {
 	tests := map[string]struct {
 		value     option.Option[int]
@@ -257,7 +317,7 @@ func TestMapSameType(t *testing.T) {
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
 			optB := option.Map(tc.value, tc.function)
-			if optB != tc.result {
+			if !equal(optB, tc.result) {
 				t.Fail()
 			}
 		})
@@ -290,7 +350,7 @@ func TestMapDifferentType(t *testing.T) {
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
 			optB := option.Map(tc.value, tc.function)
-			if optB != tc.result {
+			if !equal(optB, tc.result) {
 				t.Fail()
 			}
 		})
@@ -329,7 +389,7 @@ func TestInspect(t *testing.T) {
 			if closureValue != tc.closureResult {
 				t.Fail()
 			}
-			if val != copy {
+			if !equal(val, copy) {
 				t.Fail()
 			}
 		})
@@ -527,7 +587,7 @@ func TestAndSameType(t *testing.T) {
 
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
-			if option.And(tc.value, tc.other) != tc.expected {
+			if !equal(option.And(tc.value, tc.other), tc.expected) {
 				t.Fail()
 			}
 		})
@@ -564,7 +624,7 @@ func TestAndDifferentType(t *testing.T) {
 
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
-			if option.And(tc.value, tc.other) != tc.expected {
+			if !equal(option.And(tc.value, tc.other), tc.expected) {
 				t.Fail()
 			}
 		})
@@ -607,7 +667,7 @@ func TestAndThenSameType(t *testing.T) {
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
 			optB := option.AndThen(tc.value, tc.function)
-			if optB != tc.result {
+			if !equal(optB, tc.result) {
 				t.Fail()
 			}
 		})
@@ -651,7 +711,7 @@ func TestAndThenDifferentType(t *testing.T) {
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
 			optB := option.AndThen(tc.value, tc.function)
-			if optB != tc.result {
+			if !equal(optB, tc.result) {
 				t.Fail()
 			}
 		})
@@ -683,7 +743,7 @@ func TestFilter(t *testing.T) {
 
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
-			if tc.value.Filter(tc.predicate) != tc.expected {
+			if !equal(tc.value.Filter(tc.predicate), tc.expected) {
 				t.Fail()
 			}
 		})
@@ -719,7 +779,7 @@ func TestOr(t *testing.T) {
 
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
-			if tc.value.Or(tc.other) != tc.expected {
+			if !equal(tc.value.Or(tc.other), tc.expected) {
 				t.Fail()
 			}
 		})
@@ -761,7 +821,7 @@ func TestOrElse(t *testing.T) {
 
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
-			if tc.value.OrElse(tc.other) != tc.expected {
+			if !equal(tc.value.OrElse(tc.other), tc.expected) {
 				t.Fail()
 			}
 		})
@@ -797,7 +857,7 @@ func TestXor(t *testing.T) {
 
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
-			if tc.value.Xor(tc.other) != tc.expected {
+			if !equal(tc.value.Xor(tc.other), tc.expected) {
 				t.Fail()
 			}
 		})
@@ -824,11 +884,11 @@ func TestInsert(t *testing.T) {
 			if *ref != tc.newInner {
 				t.Fail()
 			}
-			if tc.value != option.Some(tc.newInner) {
+			if !equal(tc.value, option.Some(tc.newInner)) {
 				t.Fail()
 			}
 			*ref = *ref + 2
-			if tc.value != option.Some(tc.newInner+2) {
+			if !equal(tc.value, option.Some(tc.newInner+2)) {
 				t.Fail()
 			}
 		})
@@ -861,11 +921,11 @@ func TestGetOrInsert(t *testing.T) {
 			if *ref != tc.resultInner {
 				t.Fail()
 			}
-			if tc.value != tc.result {
+			if !equal(tc.value, tc.result) {
 				t.Fail()
 			}
 			*ref = *ref + 2
-			if tc.value != option.Some(tc.resultInner+2) {
+			if !equal(tc.value, option.Some(tc.resultInner+2)) {
 				t.Fail()
 			}
 		})
@@ -895,11 +955,11 @@ func TestGetOrInsertDefault(t *testing.T) {
 			if *ref != tc.resultInner {
 				t.Fail()
 			}
-			if tc.value != tc.result {
+			if !equal(tc.value, tc.result) {
 				t.Fail()
 			}
 			*ref = *ref + 2
-			if tc.value != option.Some(tc.resultInner+2) {
+			if !equal(tc.value, option.Some(tc.resultInner+2)) {
 				t.Fail()
 			}
 		})
@@ -939,11 +999,11 @@ func TestGetOrInsertWith(t *testing.T) {
 			if *ref != tc.resultInner {
 				t.Fail()
 			}
-			if tc.value != tc.result {
+			if !equal(tc.value, tc.result) {
 				t.Fail()
 			}
 			*ref = *ref + 2
-			if tc.value != option.Some(tc.resultInner+2) {
+			if !equal(tc.value, option.Some(tc.resultInner+2)) {
 				t.Fail()
 			}
 		})
@@ -965,10 +1025,10 @@ func TestTake(t *testing.T) {
 		t.Run(tname, func(t *testing.T) {
 			copy := tc.value.Copy()
 			val := tc.value.Take()
-			if tc.value != option.None[int]() {
+			if !equal(tc.value, option.None[int]()) {
 				t.Fail()
 			}
-			if val != copy {
+			if !equal(val, copy) {
 				t.Fail()
 			}
 		})
@@ -996,10 +1056,10 @@ func TestReplace(t *testing.T) {
 		t.Run(tname, func(t *testing.T) {
 			copy := tc.value.Copy()
 			old := tc.value.Replace(tc.newInner)
-			if tc.value != tc.result {
+			if !equal(tc.value, tc.result) {
 				t.Fail()
 			}
-			if old != copy {
+			if !equal(old, copy) {
 				t.Fail()
 			}
 		})
@@ -1055,7 +1115,7 @@ func TestCopy(t *testing.T) {
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
 			copy := tc.value.Copy()
-			if copy != tc.result {
+			if !equal(copy, tc.result) {
 				t.Fail()
 			}
 			if &copy == &tc.value {
@@ -1086,7 +1146,7 @@ func TestFlatten(t *testing.T) {
 	for tname, tc := range tests {
 		t.Run(tname, func(t *testing.T) {
 			flattened := option.Flatten(tc.value)
-			if flattened != tc.result {
+			if !equal(flattened, tc.result) {
 				t.Fail()
 			}
 		})
@@ -0,0 +1,67 @@
+// Package optionsql provides helpers for running single-row
+// queries whose result may be missing. A query that matches
+// no rows returns `None` instead of sql.ErrNoRows.
+package optionsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+// Querier is the minimal interface needed to run a single-row
+// query. It is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Row is a single result row that can be scanned into
+// destination values. It is implemented by *sql.Row.
+type Row interface {
+	Scan(dest ...any) error
+}
+
+// QueryRow runs a query expected to return at most one row and
+// scans the row with `scan`. If the query matches no rows,
+// QueryRow returns `None` and a nil error. Any other failure,
+// including one returned by `scan`, is returned as an error. The
+// row is closed when QueryRow returns, even if `scan` did not scan it.
+func QueryRow[T any](ctx context.Context, q Querier, scan func(Row) (T, error), query string, args ...any) (option.Option[T], error) {
+	row := q.QueryRowContext(ctx, query, args...)
+	t, err := scan(row)
+	// Scanning a row closes it and releases its connection, so scan
+	// it again in case scan returned without doing so. Scanning a
+	// row that was already scanned has no effect.
+	_ = row.Scan()
+	if errors.Is(err, sql.ErrNoRows) {
+		return option.None[T](), nil
+	}
+	if err != nil {
+		return option.None[T](), err
+	}
+	return option.Some(t), nil
+}
+
+// QueryRowFields runs a query expected to return at most one row
+// and scans its columns directly into the fields of a T. The
+// `fields` function returns pointers to the fields of the given
+// value, in column order.
+func QueryRowFields[T any](ctx context.Context, q Querier, fields func(*T) []any, query string, args ...any) (option.Option[T], error) {
+	return QueryRow(ctx, q, func(row Row) (T, error) {
+		var t T
+		err := row.Scan(fields(&t)...)
+		return t, err
+	}, query, args...)
+}
+
+// QueryValue runs a query expected to return at most one row
+// with a single column, and scans that column into a T.
+func QueryValue[T any](ctx context.Context, q Querier, query string, args ...any) (option.Option[T], error) {
+	return QueryRow(ctx, q, func(row Row) (T, error) {
+		var t T
+		err := row.Scan(&t)
+		return t, err
+	}, query, args...)
+}
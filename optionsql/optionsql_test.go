@@ -0,0 +1,184 @@
+package optionsql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+	"github.com/JustinKnueppel/go-option/internal/fakesql"
+	"github.com/JustinKnueppel/go-option/optionsql"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func userFields(u *user) []any {
+	return []any{&u.ID, &u.Name}
+}
+
+// equal reports whether a and b are both `None`, or both `Some`
+// with equal values, ignoring the origin recorded in builds with
+// the optiondebug tag.
+func equal[T comparable](a, b option.Option[T]) bool {
+	x, okA := a.Get()
+	y, okB := b.Get()
+	return okA == okB && (!okA || x == y)
+}
+
+func TestQueryRow(t *testing.T) {
+	errScan := errors.New("scan failed")
+	tests := map[string]struct {
+		query         string
+		args          []any
+		scan          func(optionsql.Row) (int, error)
+		result        option.Option[int]
+		expectedError error
+	}{
+		"row": {
+			query: "SELECT ?",
+			args:  []any{1},
+			scan: func(row optionsql.Row) (int, error) {
+				var x int
+				err := row.Scan(&x)
+				return x * 2, err
+			},
+			result: option.Some(2),
+		},
+		"no_rows": {
+			query: "SELECT",
+			scan: func(row optionsql.Row) (int, error) {
+				var x int
+				err := row.Scan(&x)
+				return x, err
+			},
+			result: option.None[int](),
+		},
+		"query_error": {
+			query: fakesql.ErrorQuery,
+			scan: func(row optionsql.Row) (int, error) {
+				var x int
+				err := row.Scan(&x)
+				return x, err
+			},
+			result:        option.None[int](),
+			expectedError: fakesql.ErrQuery,
+		},
+		"scan_error": {
+			query: "SELECT ?",
+			args:  []any{1},
+			scan: func(row optionsql.Row) (int, error) {
+				return 0, errScan
+			},
+			result:        option.None[int](),
+			expectedError: errScan,
+		},
+	}
+
+	db, err := fakesql.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result, err := optionsql.QueryRow(context.Background(), db, tc.scan, tc.query, tc.args...)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("got error %v, want %v", err, tc.expectedError)
+			}
+			if !equal(result, tc.result) {
+				t.Fail()
+			}
+			// The row must be closed even if scan did not scan it.
+			if inUse := db.Stats().InUse; inUse != 0 {
+				t.Errorf("%d connections still in use", inUse)
+			}
+		})
+	}
+}
+func TestQueryRowFields(t *testing.T) {
+	tests := map[string]struct {
+		query         string
+		args          []any
+		result        option.Option[user]
+		expectedError bool
+	}{
+		"row": {
+			query:  "SELECT ?, ?",
+			args:   []any{1, "a"},
+			result: option.Some(user{ID: 1, Name: "a"}),
+		},
+		"no_rows": {
+			query:  "SELECT",
+			result: option.None[user](),
+		},
+		"column_mismatch": {
+			query:         "SELECT ?",
+			args:          []any{1},
+			result:        option.None[user](),
+			expectedError: true,
+		},
+	}
+
+	db, err := fakesql.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result, err := optionsql.QueryRowFields(context.Background(), db, userFields, tc.query, tc.args...)
+			if (err != nil) != tc.expectedError {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !equal(result, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestQueryValue(t *testing.T) {
+	db, err := fakesql.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	tests := map[string]struct {
+		query  string
+		args   []any
+		result option.Option[string]
+	}{
+		"row": {
+			query:  "SELECT ?",
+			args:   []any{"a"},
+			result: option.Some("a"),
+		},
+		"no_rows": {
+			query:  "SELECT",
+			result: option.None[string](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result, err := optionsql.QueryValue[string](context.Background(), tx, tc.query, tc.args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal(result, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
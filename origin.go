@@ -0,0 +1,15 @@
+//go:build !optiondebug
+
+package option
+
+// origin records where a `None` was created. Outside of debug
+// builds it is empty and takes up no space in an Option.
+type origin struct{}
+
+func newOrigin() origin {
+	return origin{}
+}
+
+func (origin) String() string {
+	return ""
+}
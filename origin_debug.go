@@ -0,0 +1,49 @@
+//go:build optiondebug
+
+package option
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// maxOriginDepth is the number of stack frames recorded
+// for each `None`.
+const maxOriginDepth = 32
+
+// origin records the stack at which a `None` was created.
+// It is only populated in builds with the optiondebug tag.
+//
+// Since it is part of the value, it breaks == on Options in those
+// builds: `None`s created in different places are not equal, and
+// neither are `None()` and the zero value.
+type origin struct {
+	pcs *[]uintptr
+}
+
+func newOrigin() origin {
+	pcs := make([]uintptr, maxOriginDepth)
+	// Skip runtime.Callers, newOrigin and None.
+	n := runtime.Callers(3, pcs)
+	pcs = pcs[:n]
+	return origin{pcs: &pcs}
+}
+
+// String formats the recorded stack in the same layout as
+// a goroutine trace.
+func (o origin) String() string {
+	if o.pcs == nil {
+		return ""
+	}
+	var b strings.Builder
+	frames := runtime.CallersFrames(*o.pcs)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
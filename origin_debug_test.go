@@ -0,0 +1,109 @@
+//go:build optiondebug
+
+package option_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func lookupMissing() option.Option[int] {
+	return option.None[int]()
+}
+
+func rejectAll(x int) bool {
+	return false
+}
+
+func TestDebugOrigin(t *testing.T) {
+	tests := map[string]struct {
+		value  func() option.Option[string]
+		origin string
+	}{
+		"none": {
+			value: func() option.Option[string] {
+				return option.Map(lookupMissing(), func(x int) string { return "" })
+			},
+			origin: "option_test.lookupMissing",
+		},
+		"and_then_chain": {
+			value: func() option.Option[string] {
+				doubled := option.Map(lookupMissing(), func(x int) int { return x * 2 })
+				return option.AndThen(doubled, func(x int) option.Option[string] { return option.Some("") })
+			},
+			origin: "option_test.lookupMissing",
+		},
+		"filter": {
+			value: func() option.Option[string] {
+				return option.Map(option.Some(1).Filter(rejectAll), func(x int) string { return "" })
+			},
+			origin: "option.Option[...].Filter",
+		},
+		"do": {
+			value: func() option.Option[string] {
+				return option.Do(func(s *option.Scope) string {
+					option.Bind(s, lookupMissing())
+					return ""
+				})
+			},
+			origin: "option_test.lookupMissing",
+		},
+		"zip": {
+			value: func() option.Option[string] {
+				return option.ZipWith(option.Some(1), lookupMissing(), func(a, b int) string { return "" })
+			},
+			origin: "option_test.lookupMissing",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(*option.NoneError)
+				if !ok {
+					t.FailNow()
+				}
+				firstFrame, _, _ := strings.Cut(err.Origin, "\n")
+				if !strings.HasSuffix(firstFrame, tc.origin) {
+					t.Errorf("got origin %q, want %q", firstFrame, tc.origin)
+				}
+				if !strings.Contains(err.Error(), err.Origin) {
+					t.Fail()
+				}
+			}()
+			tc.value().Unwrap()
+		})
+	}
+}
+func TestDebugExpect(t *testing.T) {
+	defer func() {
+		err, ok := recover().(*option.NoneError)
+		if !ok || err.Message != "missing" {
+			t.FailNow()
+		}
+		if !strings.Contains(err.Origin, "option_test.lookupMissing") {
+			t.Fail()
+		}
+	}()
+	lookupMissing().Expect("missing")
+}
+func TestDebugZeroValue(t *testing.T) {
+	defer func() {
+		err, ok := recover().(*option.NoneError)
+		if !ok || err.Origin != "" {
+			t.Fail()
+		}
+	}()
+	var o option.Option[int]
+	o.Unwrap()
+}
+func TestDebugSemantics(t *testing.T) {
+	if !lookupMissing().IsNone() || option.Map(lookupMissing(), func(x int) int { return x }).IsSome() {
+		t.Fail()
+	}
+	if v, ok := option.Map(option.Some(1), func(x int) int { return x + 1 }).Get(); !ok || v != 2 {
+		t.Fail()
+	}
+}
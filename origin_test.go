@@ -0,0 +1,67 @@
+//go:build !optiondebug
+
+package option
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestOriginSize(t *testing.T) {
+	var o Option[byte]
+	if unsafe.Sizeof(o.origin) != 0 {
+		t.Fail()
+	}
+	// A zero-size field adds padding only when it is the last field.
+	if unsafe.Offsetof(o.origin) != 0 {
+		t.Fail()
+	}
+}
+func TestSize(t *testing.T) {
+	// The size of an Option before origins were added.
+	type baseline[T any] struct {
+		data     T
+		has_data bool
+	}
+	tests := map[string]struct {
+		size     uintptr
+		baseline uintptr
+	}{
+		"byte": {
+			size:     unsafe.Sizeof(Option[byte]{}),
+			baseline: unsafe.Sizeof(baseline[byte]{}),
+		},
+		"int": {
+			size:     unsafe.Sizeof(Option[int]{}),
+			baseline: unsafe.Sizeof(baseline[int]{}),
+		},
+		"string": {
+			size:     unsafe.Sizeof(Option[string]{}),
+			baseline: unsafe.Sizeof(baseline[string]{}),
+		},
+		"struct": {
+			size:     unsafe.Sizeof(Option[struct{ a, b int32 }]{}),
+			baseline: unsafe.Sizeof(baseline[struct{ a, b int32 }]{}),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.size != tc.baseline {
+				t.Fail()
+			}
+		})
+	}
+	if unsafe.Sizeof(Option[int]{}) != 2*unsafe.Sizeof(int(0)) || unsafe.Sizeof(Option[byte]{}) != 2 {
+		t.Fail()
+	}
+}
+func TestOriginNotRecorded(t *testing.T) {
+	defer func() {
+		err, ok := recover().(*NoneError)
+		if !ok || err.Origin != "" {
+			t.Fail()
+		}
+	}()
+	None[int]().Unwrap()
+}
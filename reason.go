@@ -0,0 +1,209 @@
+package option
+
+// Reasoned is an Option that can carry a short reason explaining
+// why its value is missing. The reason is kept beside the Option
+// rather than in it, so an Option keeps its size and equality.
+// The zero value is a `None` with no reason.
+type Reasoned[T any] struct {
+	opt    Option[T]
+	reason string
+}
+
+// NoneBecause returns a Reasoned with no value, labeled with the
+// reason the value is missing. An empty reason is the same as
+// no reason.
+func NoneBecause[T any](reason string) Reasoned[T] {
+	return Reasoned[T]{
+		opt:    Option[T]{origin: newOrigin()},
+		reason: reason,
+	}
+}
+
+// SomeReasoned returns a Reasoned with some value of type T.
+func SomeReasoned[T any](data T) Reasoned[T] {
+	return Reasoned[T]{opt: Some(data)}
+}
+
+// Because returns the option as a Reasoned, labeled with reason
+// if it is `None`. A `Some` option has no reason.
+func Because[T any](o Option[T], reason string) Reasoned[T] {
+	if o.IsSome() {
+		return Reasoned[T]{opt: o}
+	}
+	return Reasoned[T]{opt: o, reason: reason}
+}
+
+// Option returns the option without its reason.
+func (r Reasoned[T]) Option() Option[T] {
+	return r.opt
+}
+
+// Reason returns the reason the value is missing if it is
+// a `None` with a reason, otherwise returns `None`.
+func (r Reasoned[T]) Reason() Option[string] {
+	if r.opt.IsSome() || r.reason == "" {
+		return None[string]()
+	}
+	return Some(r.reason)
+}
+
+// IsSome returns `true` if it contains a value.
+func (r Reasoned[T]) IsSome() bool {
+	return r.opt.IsSome()
+}
+
+// IsSomeAnd returns `true` if it contains a value and the
+// value matches a predicate.
+func (r Reasoned[T]) IsSomeAnd(f func(T) bool) bool {
+	return r.opt.IsSomeAnd(f)
+}
+
+// IsNone returns `true` if it does not contain a value.
+func (r Reasoned[T]) IsNone() bool {
+	return r.opt.IsNone()
+}
+
+// Expect returns the contained value. Panics with a *NoneError
+// carrying msg and the reason if there is no value.
+func (r Reasoned[T]) Expect(msg string) T {
+	if r.opt.IsNone() {
+		panicNone(newNoneError(r.opt, msg, r.reason))
+	}
+	return r.opt.data
+}
+
+// Unwrap returns the contained value. Panics with a *NoneError
+// carrying the reason if there is no value.
+func (r Reasoned[T]) Unwrap() T {
+	if r.opt.IsNone() {
+		panicNone(newNoneError(r.opt, "No value in Option", r.reason))
+	}
+	return r.opt.data
+}
+
+// UnwrapOr returns the contained value or the provided default.
+func (r Reasoned[T]) UnwrapOr(data T) T {
+	return r.opt.UnwrapOr(data)
+}
+
+// UnwrapOrElse returns the contained value or computes it
+// from a closure.
+func (r Reasoned[T]) UnwrapOrElse(f func() T) T {
+	return r.opt.UnwrapOrElse(f)
+}
+
+// UnwrapOrDefault returns the contained value or the zero
+// value of type T.
+func (r Reasoned[T]) UnwrapOrDefault() T {
+	return r.opt.UnwrapOrDefault()
+}
+
+// Get returns the contained value and `true`, or the zero
+// value of type T and `false` if there is no value.
+func (r Reasoned[T]) Get() (T, bool) {
+	return r.opt.Get()
+}
+
+// Inspect calls f with the contained value if there is one
+// and returns itself unchanged.
+func (r Reasoned[T]) Inspect(f func(T)) Reasoned[T] {
+	r.opt.Inspect(f)
+	return r
+}
+
+// Filter returns `None` without a reason if the predicate
+// rejects the contained value, otherwise returns itself.
+// A `None` keeps the reason it already has.
+func (r Reasoned[T]) Filter(f func(T) bool) Reasoned[T] {
+	return Reasoned[T]{opt: r.opt.Filter(f), reason: r.reason}
+}
+
+// FilterBecause is like Filter, but labels the `None` it returns
+// with reason when the predicate rejects the contained value.
+func (o Option[T]) FilterBecause(f func(T) bool, reason string) Reasoned[T] {
+	return Reasoned[T]{opt: o}.FilterBecause(f, reason)
+}
+
+// FilterBecause is like Filter, but labels the `None` it returns
+// with reason when the predicate rejects the contained value.
+// A `None` keeps the reason it already has.
+func (r Reasoned[T]) FilterBecause(f func(T) bool, reason string) Reasoned[T] {
+	if r.opt.IsSome() && !f(r.opt.data) {
+		return NoneBecause[T](reason)
+	}
+	return r
+}
+
+// Or returns itself if it contains a value, otherwise returns
+// rB. If both are `None` and only the first has a reason, the
+// first is returned.
+func (r Reasoned[T]) Or(rB Reasoned[T]) Reasoned[T] {
+	if r.opt.IsSome() || rB.opt.IsNone() && rB.reason == "" && r.reason != "" {
+		return r
+	}
+	return rB
+}
+
+// OrElse returns itself if it contains a value, otherwise
+// calls `f` and returns the result as Or would.
+func (r Reasoned[T]) OrElse(f func() Reasoned[T]) Reasoned[T] {
+	if r.opt.IsSome() {
+		return r
+	}
+	return r.Or(f())
+}
+
+// Xor returns the one that contains a value if exactly one of
+// them does. If neither does, the result is as Or would return.
+// If both do, it returns `None` without a reason.
+func (r Reasoned[T]) Xor(rB Reasoned[T]) Reasoned[T] {
+	if r.opt.IsSome() && rB.opt.IsSome() {
+		return Reasoned[T]{opt: None[T]()}
+	}
+	return r.Or(rB)
+}
+
+// MapReasoned applies f to the contained value if there is one,
+// otherwise returns `None` with the same reason.
+func MapReasoned[T any, U any](r Reasoned[T], f func(T) U) Reasoned[U] {
+	return Reasoned[U]{opt: Map(r.opt, f), reason: r.reason}
+}
+
+// MapOrReasoned returns the provided default result if there
+// is no value, or applies f to the contained value.
+func MapOrReasoned[T any, U any](r Reasoned[T], fallback U, f func(T) U) U {
+	return MapOr(r.opt, fallback, f)
+}
+
+// MapOrElseReasoned computes a default result if there is no
+// value, or applies f to the contained value.
+func MapOrElseReasoned[T any, U any](r Reasoned[T], fallbackFn func() U, f func(T) U) U {
+	return MapOrElse(r.opt, fallbackFn, f)
+}
+
+// AndReasoned returns `None` with the same reason if there is
+// no value, otherwise returns rB.
+func AndReasoned[T any, U any](r Reasoned[T], rB Reasoned[U]) Reasoned[U] {
+	if r.opt.IsNone() {
+		return Reasoned[U]{opt: noneFrom[U](r.opt), reason: r.reason}
+	}
+	return rB
+}
+
+// AndThenReasoned returns `None` with the same reason if there is
+// no value, otherwise calls f with the value and returns the result.
+func AndThenReasoned[T any, U any](r Reasoned[T], f func(T) Reasoned[U]) Reasoned[U] {
+	if r.opt.IsNone() {
+		return Reasoned[U]{opt: noneFrom[U](r.opt), reason: r.reason}
+	}
+	return f(r.opt.data)
+}
+
+// AndThenBecause is like AndThenReasoned for an f that returns
+// an Option. The `None` returned by f is labeled with reason.
+func AndThenBecause[T any, U any](r Reasoned[T], f func(T) Option[U], reason string) Reasoned[U] {
+	if r.opt.IsNone() {
+		return Reasoned[U]{opt: noneFrom[U](r.opt), reason: r.reason}
+	}
+	return Because(f(r.opt.data), reason)
+}
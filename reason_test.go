@@ -0,0 +1,364 @@
+package option_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestNoneBecause(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Reasoned[int]
+		reason string
+	}{
+		"reason": {
+			value:  option.NoneBecause[int]("not found"),
+			reason: "not found",
+		},
+		"empty_reason": {
+			value:  option.NoneBecause[int](""),
+			reason: "",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !tc.value.IsNone() || tc.value.IsSome() || tc.value.Option().IsSome() {
+				t.Fail()
+			}
+			if reason, _ := tc.value.Reason().Get(); reason != tc.reason {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestSomeReasoned(t *testing.T) {
+	r := option.SomeReasoned(1)
+	if !r.IsSome() || r.IsNone() || r.Unwrap() != 1 || r.Reason().IsSome() {
+		t.Fail()
+	}
+}
+func TestBecause(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		reason string
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			reason: "",
+		},
+		"no_value": {
+			value:  option.None[int](),
+			reason: "not found",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			r := option.Because(tc.value, "not found")
+			if r.IsSome() != tc.value.IsSome() || r.UnwrapOr(0) != tc.value.UnwrapOr(0) {
+				t.Fail()
+			}
+			if reason, _ := r.Reason().Get(); reason != tc.reason {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestReason(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Reasoned[int]
+		reason string
+	}{
+		"some_value": {
+			value:  option.SomeReasoned(1),
+			reason: "",
+		},
+		"no_value": {
+			value:  option.Reasoned[int]{},
+			reason: "",
+		},
+		"no_value_reason": {
+			value:  option.NoneBecause[int]("not found"),
+			reason: "not found",
+		},
+		"map": {
+			value:  option.MapReasoned(option.NoneBecause[int]("not found"), func(x int) int { return x }),
+			reason: "not found",
+		},
+		"and_then": {
+			value:  option.AndThenReasoned(option.NoneBecause[string]("not found"), func(string) option.Reasoned[int] { return option.SomeReasoned(1) }),
+			reason: "not found",
+		},
+		"and_then_inner": {
+			value:  option.AndThenReasoned(option.SomeReasoned(1), func(int) option.Reasoned[int] { return option.NoneBecause[int]("inner") }),
+			reason: "inner",
+		},
+		"filter": {
+			value:  option.NoneBecause[int]("not found").FilterBecause(func(int) bool { return true }, "rejected"),
+			reason: "not found",
+		},
+		"or_none": {
+			value:  option.NoneBecause[int]("not found").Or(option.Reasoned[int]{}),
+			reason: "not found",
+		},
+		"or_none_reason": {
+			value:  option.NoneBecause[int]("first").Or(option.NoneBecause[int]("second")),
+			reason: "second",
+		},
+		"or_some": {
+			value:  option.NoneBecause[int]("not found").Or(option.SomeReasoned(1)),
+			reason: "",
+		},
+		"filter_plain": {
+			value:  option.NoneBecause[int]("not found").Filter(func(int) bool { return true }),
+			reason: "not found",
+		},
+		"filter_plain_rejected": {
+			value:  option.SomeReasoned(1).Filter(func(int) bool { return false }),
+			reason: "",
+		},
+		"xor_none": {
+			value:  option.NoneBecause[int]("first").Xor(option.NoneBecause[int]("second")),
+			reason: "second",
+		},
+		"and": {
+			value:  option.AndReasoned(option.NoneBecause[string]("not found"), option.SomeReasoned(1)),
+			reason: "not found",
+		},
+		"and_inner": {
+			value:  option.AndReasoned(option.SomeReasoned("one"), option.NoneBecause[int]("inner")),
+			reason: "inner",
+		},
+		"or_else_none": {
+			value:  option.NoneBecause[int]("not found").OrElse(func() option.Reasoned[int] { return option.Reasoned[int]{} }),
+			reason: "not found",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if reason, _ := tc.value.Reason().Get(); reason != tc.reason {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestFilterBecause(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		result option.Option[int]
+		reason string
+	}{
+		"some_accepted": {
+			value:  option.Some(2),
+			result: option.Some(2),
+			reason: "",
+		},
+		"some_rejected": {
+			value:  option.Some(1),
+			result: option.None[int](),
+			reason: "odd",
+		},
+		"no_value": {
+			value:  option.None[int](),
+			result: option.None[int](),
+			reason: "",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			filtered := tc.value.FilterBecause(func(x int) bool { return x%2 == 0 }, "odd")
+			if filtered.IsSome() != tc.result.IsSome() || filtered.UnwrapOr(0) != tc.result.UnwrapOr(0) {
+				t.Fail()
+			}
+			if reason, _ := filtered.Reason().Get(); reason != tc.reason {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestAndThenBecause(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Reasoned[int]
+		fn     func(int) option.Option[string]
+		result option.Option[string]
+		reason string
+	}{
+		"some_some": {
+			value:  option.SomeReasoned(1),
+			fn:     func(int) option.Option[string] { return option.Some("one") },
+			result: option.Some("one"),
+			reason: "",
+		},
+		"some_none": {
+			value:  option.SomeReasoned(1),
+			fn:     func(int) option.Option[string] { return option.None[string]() },
+			result: option.None[string](),
+			reason: "lookup failed",
+		},
+		"no_value_reason": {
+			value:  option.NoneBecause[int]("not found"),
+			fn:     func(int) option.Option[string] { return option.Some("one") },
+			result: option.None[string](),
+			reason: "not found",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			result := option.AndThenBecause(tc.value, tc.fn, "lookup failed")
+			if result.IsSome() != tc.result.IsSome() || result.UnwrapOr("") != tc.result.UnwrapOr("") {
+				t.Fail()
+			}
+			if reason, _ := result.Reason().Get(); reason != tc.reason {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestReasonUnwrap(t *testing.T) {
+	defer func() {
+		err, ok := recover().(*option.NoneError)
+		if !ok || err.Reason != "not found" {
+			t.FailNow()
+		}
+		if !strings.Contains(err.Error(), "not found") {
+			t.Fail()
+		}
+	}()
+	option.NoneBecause[int]("not found").Unwrap()
+}
+func TestReasonedFilter(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Reasoned[int]
+		result option.Option[int]
+	}{
+		"some_accepted": {
+			value:  option.SomeReasoned(2),
+			result: option.Some(2),
+		},
+		"some_rejected": {
+			value:  option.SomeReasoned(1),
+			result: option.None[int](),
+		},
+		"no_value": {
+			value:  option.NoneBecause[int]("not found"),
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			filtered := tc.value.Filter(func(x int) bool { return x%2 == 0 })
+			if !equal(filtered.Option(), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestReasonedXor(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Reasoned[int]
+		other  option.Reasoned[int]
+		result option.Option[int]
+	}{
+		"some_some": {
+			value:  option.SomeReasoned(1),
+			other:  option.SomeReasoned(2),
+			result: option.None[int](),
+		},
+		"some_none": {
+			value:  option.SomeReasoned(1),
+			other:  option.NoneBecause[int]("not found"),
+			result: option.Some(1),
+		},
+		"none_some": {
+			value:  option.NoneBecause[int]("not found"),
+			other:  option.SomeReasoned(2),
+			result: option.Some(2),
+		},
+		"none_none": {
+			value:  option.NoneBecause[int]("not found"),
+			other:  option.Reasoned[int]{},
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(tc.value.Xor(tc.other).Option(), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestReasonedUnwrap(t *testing.T) {
+	tests := map[string]struct {
+		value    option.Reasoned[int]
+		expected int
+		has      bool
+	}{
+		"some_value": {
+			value:    option.SomeReasoned(1),
+			expected: 1,
+			has:      true,
+		},
+		"no_value": {
+			value:    option.NoneBecause[int]("not found"),
+			expected: 0,
+			has:      false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.UnwrapOrDefault() != tc.expected {
+				t.Fail()
+			}
+			if tc.value.UnwrapOrElse(func() int { return 0 }) != tc.expected {
+				t.Fail()
+			}
+			if v, ok := tc.value.Get(); v != tc.expected || ok != tc.has {
+				t.Fail()
+			}
+			if tc.value.IsSomeAnd(func(int) bool { return true }) != tc.has {
+				t.Fail()
+			}
+			called := false
+			tc.value.Inspect(func(int) { called = true })
+			if called != tc.has {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestMapOrReasoned(t *testing.T) {
+	tests := map[string]struct {
+		value    option.Reasoned[int]
+		expected string
+	}{
+		"some_value": {
+			value:    option.SomeReasoned(1),
+			expected: "1",
+		},
+		"no_value": {
+			value:    option.NoneBecause[int]("not found"),
+			expected: "none",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if option.MapOrReasoned(tc.value, "none", strconv.Itoa) != tc.expected {
+				t.Fail()
+			}
+			if option.MapOrElseReasoned(tc.value, func() string { return "none" }, strconv.Itoa) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package option
+
+import "fmt"
+
+// Result represents either a success value of type T (`Ok`)
+// or a failure described by an error (`Err`).
+type Result[T any] struct {
+	data T
+	err  error
+}
+
+// Ok returns a Result with the success value data.
+func Ok[T any](data T) Result[T] {
+	return Result[T]{
+		data: data,
+		err:  nil,
+	}
+}
+
+// Err returns a Result with the failure err. If err is nil,
+// the failure is ErrNone, so Err never returns an `Ok`.
+func Err[T any](err error) Result[T] {
+	var t T
+	return Result[T]{
+		data: t,
+		err:  errOrNone(err),
+	}
+}
+
+// IsOk returns `true` if the result is an `Ok` value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsOkAnd returns `true` if the result is an `Ok` value
+// and the value inside of it matches a predicate.
+func (r Result[T]) IsOkAnd(f func(T) bool) bool {
+	return r.IsOk() && f(r.data)
+}
+
+// IsErr returns `true` if the result is an `Err` value.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// IsErrAnd returns `true` if the result is an `Err` value
+// and the error inside of it matches a predicate.
+func (r Result[T]) IsErrAnd(f func(error) bool) bool {
+	return r.IsErr() && f(r.err)
+}
+
+// Ok converts the result to an Option[T], discarding
+// the error if any.
+func (r Result[T]) Ok() Option[T] {
+	if r.IsErr() {
+		return None[T]()
+	}
+	return Some(r.data)
+}
+
+// Err converts the result to an Option[error], discarding
+// the success value if any.
+func (r Result[T]) Err() Option[error] {
+	if r.IsOk() {
+		return None[error]()
+	}
+	return Some(r.err)
+}
+
+// Expect returns the contained `Ok` value unsafely.
+// Panics with the given message and the error if `Err`.
+func (r Result[T]) Expect(msg string) T {
+	if r.IsErr() {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+	return r.data
+}
+
+// Unwrap returns the contained `Ok` value unsafely.
+// Panics with the contained error if `Err`.
+func (r Result[T]) Unwrap() T {
+	if r.IsErr() {
+		panic(r.err)
+	}
+	return r.data
+}
+
+// ExpectErr returns the contained error unsafely.
+// Panics with the given message if `Ok`.
+func (r Result[T]) ExpectErr(msg string) error {
+	if r.IsOk() {
+		panic(msg)
+	}
+	return r.err
+}
+
+// ErrValue returns the contained error unsafely.
+// Panics if `Ok`. Unlike Option.UnwrapErr, which never panics and
+// returns a value and error pair, ErrValue returns only the error.
+func (r Result[T]) ErrValue() error {
+	if r.IsOk() {
+		panic("No error in Result")
+	}
+	return r.err
+}
+
+// UnwrapOr returns the contained `Ok` value or
+// a provided default.
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.IsErr() {
+		return fallback
+	}
+	return r.data
+}
+
+// UnwrapOrElse returns the contained `Ok` value or
+// computes it from the error with a closure.
+func (r Result[T]) UnwrapOrElse(fallbackFn func(error) T) T {
+	if r.IsErr() {
+		return fallbackFn(r.err)
+	}
+	return r.data
+}
+
+// UnwrapOrDefault returns the contained `Ok` value or
+// the zero value of type T.
+func (r Result[T]) UnwrapOrDefault() T {
+	if r.IsErr() {
+		var t T
+		return t
+	}
+	return r.data
+}
+
+// MapResult maps a Result[T] to a Result[U] by applying a function
+// to the contained value if it is `Ok`, leaving an `Err` untouched.
+func MapResult[T any, U any](r Result[T], f func(T) U) Result[U] {
+	if r.IsErr() {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.data))
+}
+
+// MapErr maps a Result[T] by applying a function to the
+// contained error if it is `Err`, leaving an `Ok` untouched.
+func (r Result[T]) MapErr(f func(error) error) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Err[T](f(r.err))
+}
+
+// Inspect calls the provided closure with the contained value
+// if it is `Ok` and returns the unchanged Result.
+func (r Result[T]) Inspect(f func(T)) Result[T] {
+	if r.IsOk() {
+		f(r.data)
+	}
+	return r
+}
+
+// InspectErr calls the provided closure with the contained error
+// if it is `Err` and returns the unchanged Result.
+func (r Result[T]) InspectErr(f func(error)) Result[T] {
+	if r.IsErr() {
+		f(r.err)
+	}
+	return r
+}
+
+// MapOrResult returns the provided default result (if `Err`),
+// or applies a function to the contained value (if `Ok`).
+func MapOrResult[T any, U any](r Result[T], fallback U, f func(T) U) U {
+	if r.IsErr() {
+		return fallback
+	}
+	return f(r.data)
+}
+
+// MapOrElseResult computes a default from the error (if `Err`),
+// or applies a different function to the contained value (if `Ok`).
+func MapOrElseResult[T any, U any](r Result[T], fallbackFn func(error) U, f func(T) U) U {
+	if r.IsErr() {
+		return fallbackFn(r.err)
+	}
+	return f(r.data)
+}
+
+// AndResult returns the error if the result is `Err`,
+// otherwise returns resB.
+func AndResult[T any, U any](r Result[T], resB Result[U]) Result[U] {
+	if r.IsErr() {
+		return Err[U](r.err)
+	}
+	return resB
+}
+
+// AndThenResult returns the error if the result is `Err`,
+// otherwise calls f with the wrapped value and returns the result.
+func AndThenResult[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.IsErr() {
+		return Err[U](r.err)
+	}
+	return f(r.data)
+}
+
+// Or returns the result if it is `Ok`,
+// otherwise returns resB.
+func (r Result[T]) Or(resB Result[T]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return resB
+}
+
+// OrElse returns the result if it is `Ok`,
+// otherwise calls `f` with the error and returns the result.
+func (r Result[T]) OrElse(f func(error) Result[T]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return f(r.err)
+}
+
+// Copy returns a value copy of the result.
+func (r Result[T]) Copy() Result[T] {
+	return r
+}
+
+// OkOr transforms the option into a Result[T], mapping
+// `Some(v)` to `Ok(v)` and `None` to `Err(err)`, or to
+// `Err(ErrNone)` if err is nil.
+func (o Option[T]) OkOr(err error) Result[T] {
+	if o.IsNone() {
+		return Err[T](err)
+	}
+	return Ok(o.data)
+}
+
+// OkOrElse transforms the option into a Result[T], mapping
+// `Some(v)` to `Ok(v)` and `None` to `Err(f())`, or to
+// `Err(ErrNone)` if f returns nil.
+func (o Option[T]) OkOrElse(f func() error) Result[T] {
+	if o.IsNone() {
+		return Err[T](f())
+	}
+	return Ok(o.data)
+}
+
+// errOrNone returns err, or ErrNone if err is nil, so that an
+// `Err` result always holds an error.
+func errOrNone(err error) error {
+	if err == nil {
+		return ErrNone
+	}
+	return err
+}
+
+// Transpose transposes an Option of a Result into a Result
+// of an Option. `None` is mapped to `Ok(None)`, `Some(Ok(v))`
+// to `Ok(Some(v))`, and `Some(Err(err))` to `Err(err)`.
+func Transpose[T any](o Option[Result[T]]) Result[Option[T]] {
+	if o.IsNone() {
+		return Ok(noneFrom[T](o))
+	}
+	if o.data.IsErr() {
+		return Err[Option[T]](o.data.err)
+	}
+	return Ok(Some(o.data.data))
+}
+
+// TransposeResult transposes a Result of an Option into an
+// Option of a Result. `Ok(None)` is mapped to `None`,
+// `Ok(Some(v))` to `Some(Ok(v))`, and `Err(err)` to `Some(Err(err))`.
+func TransposeResult[T any](r Result[Option[T]]) Option[Result[T]] {
+	if r.IsErr() {
+		return Some(Err[T](r.err))
+	}
+	if r.data.IsNone() {
+		return noneFrom[Result[T]](r.data)
+	}
+	return Some(Ok(r.data.data))
+}
@@ -0,0 +1,716 @@
+package option_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+var errTest = errors.New("test error")
+var errOther = errors.New("other error")
+
+func TestIsOk(t *testing.T) {
+	tests := map[string]struct {
+		value    option.Result[int]
+		expected bool
+	}{
+		"ok_value": {
+			value:    option.Ok(1),
+			expected: true,
+		},
+		"err_value": {
+			value:    option.Err[int](errTest),
+			expected: false,
+		},
+		"nil_err": {
+			value:    option.Err[int](nil),
+			expected: false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.IsOk() != tc.expected {
+				t.Fail()
+			}
+			if tc.value.IsErr() == tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestIsOkAnd(t *testing.T) {
+	tests := map[string]struct {
+		value     option.Result[int]
+		predicate func(int) bool
+		expected  bool
+	}{
+		"ok_value_true": {
+			value:     option.Ok(1),
+			predicate: func(x int) bool { return x == 1 },
+			expected:  true,
+		},
+		"ok_value_false": {
+			value:     option.Ok(1),
+			predicate: func(x int) bool { return x == 2 },
+			expected:  false,
+		},
+		"err_value": {
+			value:     option.Err[int](errTest),
+			predicate: func(x int) bool { return true },
+			expected:  false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.IsOkAnd(tc.predicate) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestIsErrAnd(t *testing.T) {
+	tests := map[string]struct {
+		value     option.Result[int]
+		predicate func(error) bool
+		expected  bool
+	}{
+		"ok_value": {
+			value:     option.Ok(1),
+			predicate: func(err error) bool { return true },
+			expected:  false,
+		},
+		"err_value_true": {
+			value:     option.Err[int](errTest),
+			predicate: func(err error) bool { return errors.Is(err, errTest) },
+			expected:  true,
+		},
+		"err_value_false": {
+			value:     option.Err[int](errTest),
+			predicate: func(err error) bool { return errors.Is(err, errOther) },
+			expected:  false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.IsErrAnd(tc.predicate) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultOk(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		result option.Option[int]
+	}{
+		"ok_value": {
+			value:  option.Ok(1),
+			result: option.Some(1),
+		},
+		"err_value": {
+			value:  option.Err[int](errTest),
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(tc.value.Ok(), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultErr(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		result option.Option[error]
+	}{
+		"ok_value": {
+			value:  option.Ok(1),
+			result: option.None[error](),
+		},
+		"err_value": {
+			value:  option.Err[int](errTest),
+			result: option.Some(errTest),
+		},
+		"nil_err": {
+			value:  option.Err[int](nil),
+			result: option.Some(option.ErrNone),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(tc.value.Err(), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultExpect(t *testing.T) {
+	tests := map[string]struct {
+		value         option.Result[int]
+		inner         int
+		msg           string
+		expectedPanic string
+	}{
+		"ok_value": {
+			value: option.Ok(1),
+			inner: 1,
+			msg:   "",
+		},
+		"err_value": {
+			value:         option.Err[int](errTest),
+			msg:           "No value",
+			expectedPanic: "No value: test error",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				panicMsg := recover()
+				if tc.expectedPanic != "" && panicMsg != tc.expectedPanic {
+					t.Fail()
+				}
+			}()
+			val := tc.value.Expect(tc.msg)
+			if tc.expectedPanic != "" || val != tc.inner {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultUnwrap(t *testing.T) {
+	tests := map[string]struct {
+		value         option.Result[int]
+		inner         int
+		expectedPanic error
+	}{
+		"ok_value": {
+			value: option.Ok(1),
+			inner: 1,
+		},
+		"err_value": {
+			value:         option.Err[int](errTest),
+			expectedPanic: errTest,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				e := recover()
+				if tc.expectedPanic != nil && e != tc.expectedPanic {
+					t.Fail()
+				}
+			}()
+			val := tc.value.Unwrap()
+			if tc.expectedPanic != nil || val != tc.inner {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultErrValue(t *testing.T) {
+	tests := map[string]struct {
+		value         option.Result[int]
+		err           error
+		expectedPanic bool
+	}{
+		"ok_value": {
+			value:         option.Ok(1),
+			expectedPanic: true,
+		},
+		"err_value": {
+			value: option.Err[int](errTest),
+			err:   errTest,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				e := recover()
+				if tc.expectedPanic != (e != nil) {
+					t.Fail()
+				}
+			}()
+			if tc.value.ErrValue() != tc.err {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultExpectErr(t *testing.T) {
+	tests := map[string]struct {
+		value         option.Result[int]
+		err           error
+		msg           string
+		expectedPanic bool
+	}{
+		"ok_value": {
+			value:         option.Ok(1),
+			msg:           "No error",
+			expectedPanic: true,
+		},
+		"err_value": {
+			value: option.Err[int](errTest),
+			err:   errTest,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				panicMsg := recover()
+				if tc.expectedPanic && panicMsg != tc.msg {
+					t.Fail()
+				}
+			}()
+			if tc.value.ExpectErr(tc.msg) != tc.err {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultUnwrapOr(t *testing.T) {
+	tests := map[string]struct {
+		value     option.Result[int]
+		alternate int
+		inner     int
+	}{
+		"ok_value": {
+			value:     option.Ok(1),
+			alternate: 5,
+			inner:     1,
+		},
+		"err_value": {
+			value:     option.Err[int](errTest),
+			alternate: 5,
+			inner:     5,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.UnwrapOr(tc.alternate) != tc.inner {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultUnwrapOrElse(t *testing.T) {
+	tests := map[string]struct {
+		value    option.Result[int]
+		fallback func(error) int
+		inner    int
+	}{
+		"ok_value": {
+			value:    option.Ok(1),
+			fallback: func(error) int { return 5 },
+			inner:    1,
+		},
+		"err_value": {
+			value:    option.Err[int](errTest),
+			fallback: func(err error) int { return len(err.Error()) },
+			inner:    len(errTest.Error()),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.UnwrapOrElse(tc.fallback) != tc.inner {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultUnwrapOrDefault(t *testing.T) {
+	tests := map[string]struct {
+		value option.Result[int]
+		inner int
+	}{
+		"ok_value": {
+			value: option.Ok(1),
+			inner: 1,
+		},
+		"err_value": {
+			value: option.Err[int](errTest),
+			inner: 0,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.UnwrapOrDefault() != tc.inner {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestMapResult(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		fn     func(int) string
+		result option.Result[string]
+	}{
+		"ok_value": {
+			value:  option.Ok(1),
+			fn:     func(x int) string { return "one" },
+			result: option.Ok("one"),
+		},
+		"err_value": {
+			value:  option.Err[int](errTest),
+			fn:     func(x int) string { return "one" },
+			result: option.Err[string](errTest),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if option.MapResult(tc.value, tc.fn) != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestMapErr(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		result option.Result[int]
+	}{
+		"ok_value": {
+			value:  option.Ok(1),
+			result: option.Ok(1),
+		},
+		"err_value": {
+			value:  option.Err[int](errTest),
+			result: option.Err[int](errOther),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			mapped := tc.value.MapErr(func(error) error { return errOther })
+			if mapped != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultInspect(t *testing.T) {
+	tests := map[string]struct {
+		value    option.Result[int]
+		okCalls  int
+		errCalls int
+	}{
+		"ok_value": {
+			value:   option.Ok(1),
+			okCalls: 1,
+		},
+		"err_value": {
+			value:    option.Err[int](errTest),
+			errCalls: 1,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			okCalls, errCalls := 0, 0
+			result := tc.value.
+				Inspect(func(int) { okCalls++ }).
+				InspectErr(func(error) { errCalls++ })
+			if result != tc.value {
+				t.Fail()
+			}
+			if okCalls != tc.okCalls || errCalls != tc.errCalls {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestMapOrResult(t *testing.T) {
+	tests := map[string]struct {
+		value    option.Result[int]
+		fallback string
+		result   string
+	}{
+		"ok_value": {
+			value:    option.Ok(1),
+			fallback: "fallback",
+			result:   "mapped",
+		},
+		"err_value": {
+			value:    option.Err[int](errTest),
+			fallback: "fallback",
+			result:   "fallback",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			mapped := option.MapOrResult(tc.value, tc.fallback, func(int) string { return "mapped" })
+			if mapped != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestMapOrElseResult(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		result string
+	}{
+		"ok_value": {
+			value:  option.Ok(1),
+			result: "mapped",
+		},
+		"err_value": {
+			value:  option.Err[int](errTest),
+			result: errTest.Error(),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			mapped := option.MapOrElseResult(tc.value, func(err error) string { return err.Error() }, func(int) string { return "mapped" })
+			if mapped != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestAndResult(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		resB   option.Result[string]
+		result option.Result[string]
+	}{
+		"ok_ok": {
+			value:  option.Ok(1),
+			resB:   option.Ok("b"),
+			result: option.Ok("b"),
+		},
+		"ok_err": {
+			value:  option.Ok(1),
+			resB:   option.Err[string](errOther),
+			result: option.Err[string](errOther),
+		},
+		"err_ok": {
+			value:  option.Err[int](errTest),
+			resB:   option.Ok("b"),
+			result: option.Err[string](errTest),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if option.AndResult(tc.value, tc.resB) != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestAndThenResult(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		fn     func(int) option.Result[string]
+		result option.Result[string]
+	}{
+		"ok_ok": {
+			value:  option.Ok(1),
+			fn:     func(int) option.Result[string] { return option.Ok("one") },
+			result: option.Ok("one"),
+		},
+		"ok_err": {
+			value:  option.Ok(1),
+			fn:     func(int) option.Result[string] { return option.Err[string](errOther) },
+			result: option.Err[string](errOther),
+		},
+		"err": {
+			value:  option.Err[int](errTest),
+			fn:     func(int) option.Result[string] { return option.Ok("one") },
+			result: option.Err[string](errTest),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if option.AndThenResult(tc.value, tc.fn) != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultOr(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		resB   option.Result[int]
+		result option.Result[int]
+	}{
+		"ok_ok": {
+			value:  option.Ok(1),
+			resB:   option.Ok(2),
+			result: option.Ok(1),
+		},
+		"err_ok": {
+			value:  option.Err[int](errTest),
+			resB:   option.Ok(2),
+			result: option.Ok(2),
+		},
+		"err_err": {
+			value:  option.Err[int](errTest),
+			resB:   option.Err[int](errOther),
+			result: option.Err[int](errOther),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.Or(tc.resB) != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestResultOrElse(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Result[int]
+		fn     func(error) option.Result[int]
+		result option.Result[int]
+	}{
+		"ok_value": {
+			value:  option.Ok(1),
+			fn:     func(error) option.Result[int] { return option.Ok(2) },
+			result: option.Ok(1),
+		},
+		"err_recovered": {
+			value:  option.Err[int](errTest),
+			fn:     func(error) option.Result[int] { return option.Ok(2) },
+			result: option.Ok(2),
+		},
+		"err_replaced": {
+			value:  option.Err[int](errTest),
+			fn:     func(error) option.Result[int] { return option.Err[int](errOther) },
+			result: option.Err[int](errOther),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.OrElse(tc.fn) != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestOkOr(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		err    error
+		result option.Result[int]
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			err:    errTest,
+			result: option.Ok(1),
+		},
+		"no_value": {
+			value:  option.None[int](),
+			err:    errTest,
+			result: option.Err[int](errTest),
+		},
+		"no_value_nil_error": {
+			value:  option.None[int](),
+			err:    nil,
+			result: option.Err[int](option.ErrNone),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.OkOr(tc.err) != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestOkOrElse(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		err    error
+		calls  int
+		result option.Result[int]
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			err:    errTest,
+			calls:  0,
+			result: option.Ok(1),
+		},
+		"no_value": {
+			value:  option.None[int](),
+			err:    errTest,
+			calls:  1,
+			result: option.Err[int](errTest),
+		},
+		"no_value_nil_error": {
+			value:  option.None[int](),
+			err:    nil,
+			calls:  1,
+			result: option.Err[int](option.ErrNone),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			calls := 0
+			result := tc.value.OkOrElse(func() error {
+				calls++
+				return tc.err
+			})
+			if result != tc.result || calls != tc.calls {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestTranspose(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[option.Result[int]]
+		result option.Result[option.Option[int]]
+	}{
+		"none": {
+			value:  option.None[option.Result[int]](),
+			result: option.Ok(option.None[int]()),
+		},
+		"some_ok": {
+			value:  option.Some(option.Ok(1)),
+			result: option.Ok(option.Some(1)),
+		},
+		"some_err": {
+			value:  option.Some(option.Err[int](errTest)),
+			result: option.Err[option.Option[int]](errTest),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			transposed := option.Transpose(tc.value)
+			got, err := transposed.Get()
+			want, wantErr := tc.result.Get()
+			if !equal(got, want) || err != wantErr {
+				t.Fail()
+			}
+			if !equal(option.TransposeResult(transposed), tc.value) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package option
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer. A `Some` value logs as the
+// contained value, which is resolved further if it is itself a
+// slog.LogValuer. A `None` value logs as nil.
+func (o Option[T]) LogValue() slog.Value {
+	if o.IsNone() {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.data)
+}
+
+// Attr returns a slog.Attr for the contained value with the given
+// key. If the option is `None`, it returns an empty Attr, which
+// handlers leave out of the log record entirely.
+func Attr[T any](key string, o Option[T]) slog.Attr {
+	if o.IsNone() {
+		return slog.Attr{}
+	}
+	return slog.Any(key, o.data)
+}
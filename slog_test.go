@@ -0,0 +1,92 @@
+package option_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+type secret string
+
+func (secret) LogValue() slog.Value {
+	return slog.StringValue("REDACTED")
+}
+
+func logJSON(attrs ...slog.Attr) string {
+	var buf bytes.Buffer
+	handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	slog.New(handler).LogAttrs(context.Background(), slog.LevelInfo, "", attrs...)
+	return strings.TrimSpace(buf.String())
+}
+
+func TestLogValue(t *testing.T) {
+	tests := map[string]struct {
+		value    any
+		expected string
+	}{
+		"some_value": {
+			value:    option.Some(5),
+			expected: `{"opt":5}`,
+		},
+		"no_value": {
+			value:    option.None[int](),
+			expected: `{"opt":null}`,
+		},
+		"some_log_valuer": {
+			value:    option.Some(secret("hunter2")),
+			expected: `{"opt":"REDACTED"}`,
+		},
+		"some_some": {
+			value:    option.Some(option.Some("a")),
+			expected: `{"opt":"a"}`,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			logged := logJSON(slog.Any("opt", tc.value))
+			if logged != tc.expected {
+				t.Errorf("got %s, want %s", logged, tc.expected)
+			}
+		})
+	}
+}
+func TestAttr(t *testing.T) {
+	tests := map[string]struct {
+		attr     slog.Attr
+		expected string
+	}{
+		"some_value": {
+			attr:     option.Attr("opt", option.Some(5)),
+			expected: `{"opt":5}`,
+		},
+		"no_value": {
+			attr:     option.Attr("opt", option.None[int]()),
+			expected: `{}`,
+		},
+		"some_log_valuer": {
+			attr:     option.Attr("opt", option.Some(secret("hunter2"))),
+			expected: `{"opt":"REDACTED"}`,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			logged := logJSON(tc.attr)
+			if logged != tc.expected {
+				t.Errorf("got %s, want %s", logged, tc.expected)
+			}
+		})
+	}
+}
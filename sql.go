@@ -0,0 +1,150 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// Scan implements sql.Scanner. A NULL value scans into `None`,
+// and any other value is converted to type T using the same
+// rules that database/sql applies when scanning into a *T,
+// then wrapped in `Some`.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	if !n.Valid {
+		*o = None[T]()
+		return nil
+	}
+	*o = Some(n.V)
+	return nil
+}
+
+// Value implements driver.Valuer. A `None` value is written
+// as NULL, and a `Some` value is converted in the same way
+// database/sql converts a query argument of type T.
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.data, Valid: o.has_data}.Value()
+}
+
+// FromNull converts a sql.Null[T] to an Option[T], returning
+// `None` if the value is not valid.
+func FromNull[T any](n sql.Null[T]) Option[T] {
+	if !n.Valid {
+		return None[T]()
+	}
+	return Some(n.V)
+}
+
+// ToNull converts an Option[T] to a sql.Null[T] which is
+// valid only if the option is a `Some` value.
+func ToNull[T any](o Option[T]) sql.Null[T] {
+	return sql.Null[T]{V: o.data, Valid: o.has_data}
+}
+
+// FromNullString converts a sql.NullString to an Option[string].
+func FromNullString(n sql.NullString) Option[string] {
+	if !n.Valid {
+		return None[string]()
+	}
+	return Some(n.String)
+}
+
+// ToNullString converts an Option[string] to a sql.NullString.
+func ToNullString(o Option[string]) sql.NullString {
+	return sql.NullString{String: o.data, Valid: o.has_data}
+}
+
+// FromNullInt64 converts a sql.NullInt64 to an Option[int64].
+func FromNullInt64(n sql.NullInt64) Option[int64] {
+	if !n.Valid {
+		return None[int64]()
+	}
+	return Some(n.Int64)
+}
+
+// ToNullInt64 converts an Option[int64] to a sql.NullInt64.
+func ToNullInt64(o Option[int64]) sql.NullInt64 {
+	return sql.NullInt64{Int64: o.data, Valid: o.has_data}
+}
+
+// FromNullInt32 converts a sql.NullInt32 to an Option[int32].
+func FromNullInt32(n sql.NullInt32) Option[int32] {
+	if !n.Valid {
+		return None[int32]()
+	}
+	return Some(n.Int32)
+}
+
+// ToNullInt32 converts an Option[int32] to a sql.NullInt32.
+func ToNullInt32(o Option[int32]) sql.NullInt32 {
+	return sql.NullInt32{Int32: o.data, Valid: o.has_data}
+}
+
+// FromNullInt16 converts a sql.NullInt16 to an Option[int16].
+func FromNullInt16(n sql.NullInt16) Option[int16] {
+	if !n.Valid {
+		return None[int16]()
+	}
+	return Some(n.Int16)
+}
+
+// ToNullInt16 converts an Option[int16] to a sql.NullInt16.
+func ToNullInt16(o Option[int16]) sql.NullInt16 {
+	return sql.NullInt16{Int16: o.data, Valid: o.has_data}
+}
+
+// FromNullByte converts a sql.NullByte to an Option[byte].
+func FromNullByte(n sql.NullByte) Option[byte] {
+	if !n.Valid {
+		return None[byte]()
+	}
+	return Some(n.Byte)
+}
+
+// ToNullByte converts an Option[byte] to a sql.NullByte.
+func ToNullByte(o Option[byte]) sql.NullByte {
+	return sql.NullByte{Byte: o.data, Valid: o.has_data}
+}
+
+// FromNullFloat64 converts a sql.NullFloat64 to an Option[float64].
+func FromNullFloat64(n sql.NullFloat64) Option[float64] {
+	if !n.Valid {
+		return None[float64]()
+	}
+	return Some(n.Float64)
+}
+
+// ToNullFloat64 converts an Option[float64] to a sql.NullFloat64.
+func ToNullFloat64(o Option[float64]) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: o.data, Valid: o.has_data}
+}
+
+// FromNullBool converts a sql.NullBool to an Option[bool].
+func FromNullBool(n sql.NullBool) Option[bool] {
+	if !n.Valid {
+		return None[bool]()
+	}
+	return Some(n.Bool)
+}
+
+// ToNullBool converts an Option[bool] to a sql.NullBool.
+func ToNullBool(o Option[bool]) sql.NullBool {
+	return sql.NullBool{Bool: o.data, Valid: o.has_data}
+}
+
+// FromNullTime converts a sql.NullTime to an Option[time.Time].
+func FromNullTime(n sql.NullTime) Option[time.Time] {
+	if !n.Valid {
+		return None[time.Time]()
+	}
+	return Some(n.Time)
+}
+
+// ToNullTime converts an Option[time.Time] to a sql.NullTime.
+func ToNullTime(o Option[time.Time]) sql.NullTime {
+	return sql.NullTime{Time: o.data, Valid: o.has_data}
+}
@@ -0,0 +1,258 @@
+package option_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/JustinKnueppel/go-option"
+	"github.com/JustinKnueppel/go-option/internal/fakesql"
+)
+
+func TestScan(t *testing.T) {
+	tests := map[string]struct {
+		src           any
+		result        option.Option[int]
+		expectedError bool
+	}{
+		"null": {
+			src:    nil,
+			result: option.None[int](),
+		},
+		"int64": {
+			src:    int64(5),
+			result: option.Some(5),
+		},
+		"bytes": {
+			src:    []byte("7"),
+			result: option.Some(7),
+		},
+		"invalid": {
+			src:           "seven",
+			result:        option.Some(1),
+			expectedError: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			o := option.Some(1)
+			err := o.Scan(tc.src)
+			if (err != nil) != tc.expectedError {
+				t.Fail()
+			}
+			if !equal(o, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestValue(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		result any
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			result: int64(1),
+		},
+		"no_value": {
+			value:  option.None[int](),
+			result: nil,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			v, err := tc.value.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestSQLRoundTrip(t *testing.T) {
+	db, err := fakesql.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	now := time.Date(2022, 8, 29, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		arg    any
+		target func() any
+		result func(any) bool
+	}{
+		"some_int": {
+			arg:    option.Some(5),
+			target: func() any { return new(option.Option[int]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[int]), option.Some(5)) },
+		},
+		"none_int": {
+			arg:    option.None[int](),
+			target: func() any { return new(option.Option[int]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[int]), option.None[int]()) },
+		},
+		"some_string": {
+			arg:    option.Some("hello"),
+			target: func() any { return new(option.Option[string]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[string]), option.Some("hello")) },
+		},
+		"int_to_string": {
+			arg:    option.Some(5),
+			target: func() any { return new(option.Option[string]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[string]), option.Some("5")) },
+		},
+		"some_time": {
+			arg:    option.Some(now),
+			target: func() any { return new(option.Option[time.Time]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[time.Time]), option.Some(now)) },
+		},
+		"plain_null": {
+			arg:    nil,
+			target: func() any { return new(option.Option[float64]) },
+			result: func(v any) bool { return equal(*v.(*option.Option[float64]), option.None[float64]()) },
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			target := tc.target()
+			if err := db.QueryRow("SELECT ?", tc.arg).Scan(target); err != nil {
+				t.Fatal(err)
+			}
+			if !tc.result(target) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestFromNull(t *testing.T) {
+	tests := map[string]struct {
+		value  sql.Null[int]
+		result option.Option[int]
+	}{
+		"valid": {
+			value:  sql.Null[int]{V: 1, Valid: true},
+			result: option.Some(1),
+		},
+		"valid_zero": {
+			value:  sql.Null[int]{V: 0, Valid: true},
+			result: option.Some(0),
+		},
+		"invalid": {
+			value:  sql.Null[int]{V: 1, Valid: false},
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.FromNull(tc.value), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestToNull(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		result sql.Null[int]
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			result: sql.Null[int]{V: 1, Valid: true},
+		},
+		"no_value": {
+			value:  option.None[int](),
+			result: sql.Null[int]{V: 0, Valid: false},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if option.ToNull(tc.value) != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestNullTypes(t *testing.T) {
+	now := time.Date(2022, 8, 29, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		roundTrip func() bool
+	}{
+		"string_valid": {
+			roundTrip: func() bool {
+				n := sql.NullString{String: "a", Valid: true}
+				return equal(option.FromNullString(n), option.Some("a")) && option.ToNullString(option.FromNullString(n)) == n
+			},
+		},
+		"string_invalid": {
+			roundTrip: func() bool {
+				n := sql.NullString{}
+				return equal(option.FromNullString(n), option.None[string]()) && option.ToNullString(option.FromNullString(n)) == n
+			},
+		},
+		"int64": {
+			roundTrip: func() bool {
+				n := sql.NullInt64{Int64: 1, Valid: true}
+				return equal(option.FromNullInt64(n), option.Some[int64](1)) && option.ToNullInt64(option.FromNullInt64(n)) == n
+			},
+		},
+		"int32": {
+			roundTrip: func() bool {
+				n := sql.NullInt32{Int32: 1, Valid: true}
+				return equal(option.FromNullInt32(n), option.Some[int32](1)) && option.ToNullInt32(option.FromNullInt32(n)) == n
+			},
+		},
+		"int16": {
+			roundTrip: func() bool {
+				n := sql.NullInt16{Int16: 1, Valid: true}
+				return equal(option.FromNullInt16(n), option.Some[int16](1)) && option.ToNullInt16(option.FromNullInt16(n)) == n
+			},
+		},
+		"byte": {
+			roundTrip: func() bool {
+				n := sql.NullByte{Byte: 1, Valid: true}
+				return equal(option.FromNullByte(n), option.Some[byte](1)) && option.ToNullByte(option.FromNullByte(n)) == n
+			},
+		},
+		"float64": {
+			roundTrip: func() bool {
+				n := sql.NullFloat64{Float64: 1.5, Valid: true}
+				return equal(option.FromNullFloat64(n), option.Some(1.5)) && option.ToNullFloat64(option.FromNullFloat64(n)) == n
+			},
+		},
+		"bool": {
+			roundTrip: func() bool {
+				n := sql.NullBool{Bool: true, Valid: true}
+				return equal(option.FromNullBool(n), option.Some(true)) && option.ToNullBool(option.FromNullBool(n)) == n
+			},
+		},
+		"time": {
+			roundTrip: func() bool {
+				n := sql.NullTime{Time: now, Valid: true}
+				return equal(option.FromNullTime(n), option.Some(now)) && option.ToNullTime(option.FromNullTime(n)) == n
+			},
+		},
+		"time_invalid": {
+			roundTrip: func() bool {
+				n := sql.NullTime{}
+				return equal(option.FromNullTime(n), option.None[time.Time]()) && option.ToNullTime(option.FromNullTime(n)) == n
+			},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !tc.roundTrip() {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,483 @@
+// Package optionlint defines an Analyzer that reports common
+// misuses of option.Option.
+package optionlint
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const Doc = `report common misuses of option.Option
+
+The optionlint analyzer reports:
+
+  - Option[*T] and Option[Option[T]] in the signatures of exported
+    functions, which give a value two different ways to be missing;
+  - option.Option[T]{} composite literals, which should be written
+    as option.None[T]();
+  - == and != between Options, which compare more than the contained
+    values and should be replaced by IsSome, IsNone or option.Contains;
+  - discarded results of Filter, Or, Map and similar functions, which
+    return a new Option or Reasoned rather than modifying their argument;
+  - Insert, Take, Replace and GetOrInsert calls on a copy of an Option,
+    such as a range variable or a field of a value receiver.`
+
+// optionPath is the import path of the option package.
+const optionPath = "github.com/JustinKnueppel/go-option"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "optionlint",
+	Doc:      Doc,
+	URL:      "https://pkg.go.dev/github.com/JustinKnueppel/go-option/tools/analysis/optionlint",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// pureMethods are the methods of Option and Reasoned whose
+// only effect is their result.
+var pureMethods = map[string]bool{
+	"Filter":        true,
+	"FilterBecause": true,
+	"Or":            true,
+	"Xor":           true,
+	"Copy":          true,
+}
+
+// pureFuncs are the functions of the option package whose
+// only effect is their result.
+var pureFuncs = map[string]bool{
+	"Map":             true,
+	"And":             true,
+	"AndThen":         true,
+	"Flatten":         true,
+	"Zip":             true,
+	"ZipWith":         true,
+	"MapReasoned":     true,
+	"AndReasoned":     true,
+	"AndThenReasoned": true,
+	"AndThenBecause":  true,
+}
+
+// mutatingMethods are the methods of Option that modify it
+// through a pointer receiver.
+var mutatingMethods = map[string]bool{
+	"Insert":             true,
+	"GetOrInsert":        true,
+	"GetOrInsertDefault": true,
+	"GetOrInsertWith":    true,
+	"Take":               true,
+	"Replace":            true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	// The option package builds Options directly and its tests
+	// compare them with == on purpose.
+	if path := pass.Pkg.Path(); path == optionPath || path == optionPath+"_test" {
+		return nil, nil
+	}
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+		(*ast.CompositeLit)(nil),
+		(*ast.BinaryExpr)(nil),
+		(*ast.ExprStmt)(nil),
+		(*ast.RangeStmt)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			checkSignature(pass, n)
+			checkValueReceiver(pass, n)
+		case *ast.CompositeLit:
+			checkLiteral(pass, n)
+		case *ast.BinaryExpr:
+			checkComparison(pass, n)
+		case *ast.ExprStmt:
+			checkDiscarded(pass, n)
+		case *ast.RangeStmt:
+			checkRangeCopy(pass, n)
+		}
+	})
+	return nil, nil
+}
+
+// checkSignature reports Option[*T] and Option[Option[T]] in the
+// parameters and results of exported functions and methods.
+func checkSignature(pass *analysis.Pass, decl *ast.FuncDecl) {
+	if !decl.Name.IsExported() {
+		return
+	}
+	if decl.Recv != nil {
+		recv := pass.TypesInfo.TypeOf(decl.Recv.List[0].Type)
+		if named := namedOf(recv); named == nil || !named.Obj().Exported() {
+			return
+		}
+	}
+	fields := decl.Type.Params.List
+	if decl.Type.Results != nil {
+		fields = append(fields[:len(fields):len(fields)], decl.Type.Results.List...)
+	}
+	for _, field := range fields {
+		elem, ok := optionElem(pass.TypesInfo.TypeOf(field.Type))
+		if !ok {
+			continue
+		}
+		switch {
+		case isPointer(elem):
+			pass.Reportf(field.Type.Pos(), "exported function %s uses %s; a nil pointer inside Some is a second way to be missing, use Option[%s] instead",
+				decl.Name.Name, typeString(pass, field.Type), types.TypeString(elem.(*types.Pointer).Elem(), qualifier(pass)))
+		case isOptionType(elem):
+			pass.Reportf(field.Type.Pos(), "exported function %s uses %s; nested Options are hard to use, flatten them with option.Flatten",
+				decl.Name.Name, typeString(pass, field.Type))
+		}
+	}
+}
+
+// checkLiteral reports option.Option[T]{} literals.
+func checkLiteral(pass *analysis.Pass, lit *ast.CompositeLit) {
+	if lit.Type == nil || !isOptionType(pass.TypesInfo.TypeOf(lit)) {
+		return
+	}
+	typeArg := ""
+	if index, ok := ast.Unparen(lit.Type).(*ast.IndexExpr); ok {
+		typeArg = render(pass.Fset, index.Index)
+	}
+	var fixes []analysis.SuggestedFix
+	if typeArg != "" {
+		if sel, ok := ast.Unparen(lit.Type).(*ast.IndexExpr).X.(*ast.SelectorExpr); ok {
+			fixes = []analysis.SuggestedFix{{
+				Message: "Replace with None",
+				TextEdits: []analysis.TextEdit{{
+					Pos:     lit.Pos(),
+					End:     lit.End(),
+					NewText: fmt.Appendf(nil, "%s.None[%s]()", render(pass.Fset, sel.X), typeArg),
+				}},
+			}}
+		}
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:            lit.Pos(),
+		End:            lit.End(),
+		Message:        "Option composite literal; use option.None() to create an empty Option",
+		SuggestedFixes: fixes,
+	})
+}
+
+// checkComparison reports == and != between Options. A comparison
+// with a None() call is rewritten to IsNone or IsSome.
+func checkComparison(pass *analysis.Pass, expr *ast.BinaryExpr) {
+	if expr.Op != token.EQL && expr.Op != token.NEQ {
+		return
+	}
+	elem, ok := optionElem(pass.TypesInfo.TypeOf(expr.X))
+	if !ok {
+		return
+	}
+	for _, pair := range [][2]ast.Expr{{expr.X, expr.Y}, {expr.Y, expr.X}} {
+		if !isCallTo(pass, pair[1], "None") {
+			continue
+		}
+		method := "IsNone"
+		if expr.Op == token.NEQ {
+			method = "IsSome"
+		}
+		operand := render(pass.Fset, pair[0])
+		if !isPrimary(pair[0]) {
+			operand = "(" + operand + ")"
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:     expr.Pos(),
+			End:     expr.End(),
+			Message: fmt.Sprintf("comparison with None using %s; use %s instead", expr.Op, method),
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message: "Replace with " + method,
+				TextEdits: []analysis.TextEdit{{
+					Pos:     expr.Pos(),
+					End:     expr.End(),
+					NewText: fmt.Appendf(nil, "%s.%s()", operand, method),
+				}},
+			}},
+		})
+		return
+	}
+	if isBasic(elem) {
+		return
+	}
+	pass.Reportf(expr.Pos(), "comparison of %s using %s compares more than the contained values; use IsSome, IsNone or option.Contains",
+		types.TypeString(pass.TypesInfo.TypeOf(expr.X), qualifier(pass)), expr.Op)
+}
+
+// checkDiscarded reports calls whose only effect is a new Option
+// that is then discarded.
+func checkDiscarded(pass *analysis.Pass, stmt *ast.ExprStmt) {
+	call, ok := ast.Unparen(stmt.X).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != optionPath {
+		return
+	}
+	result := namedOf(pass.TypesInfo.TypeOf(call))
+	if result == nil {
+		return
+	}
+	recv := fn.Signature().Recv()
+	switch {
+	case recv != nil && (isOptionType(recv.Type()) || isReasonedType(recv.Type())) && pureMethods[fn.Name()]:
+		var fixes []analysis.SuggestedFix
+		sel := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		// FilterBecause returns a Reasoned, which cannot be
+		// assigned back to an Option.
+		if id, ok := ast.Unparen(sel.X).(*ast.Ident); ok && pass.TypesInfo.Types[sel.X].Addressable() &&
+			types.Identical(pass.TypesInfo.TypeOf(sel.X), result) {
+			fixes = []analysis.SuggestedFix{{
+				Message: "Assign the result to " + id.Name,
+				TextEdits: []analysis.TextEdit{{
+					Pos:     stmt.Pos(),
+					End:     stmt.Pos(),
+					NewText: []byte(id.Name + " = "),
+				}},
+			}}
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:            call.Pos(),
+			End:            call.End(),
+			Message:        fmt.Sprintf("result of %s is discarded; %s returns a new %s and does not modify its receiver", fn.Name(), fn.Name(), result.Obj().Name()),
+			SuggestedFixes: fixes,
+		})
+	case recv == nil && pureFuncs[fn.Name()]:
+		pass.Reportf(call.Pos(), "result of option.%s is discarded; option.%s returns a new %s and does not modify its arguments", fn.Name(), fn.Name(), result.Obj().Name())
+	}
+}
+
+// checkRangeCopy reports mutating methods called on the value
+// variable of a range loop, which is a copy of the element.
+func checkRangeCopy(pass *analysis.Pass, stmt *ast.RangeStmt) {
+	value, ok := stmt.Value.(*ast.Ident)
+	if !ok || stmt.Tok != token.DEFINE {
+		return
+	}
+	obj := pass.TypesInfo.Defs[value]
+	if obj == nil || !isOptionType(obj.Type()) {
+		return
+	}
+	key, _ := stmt.Key.(*ast.Ident)
+	ast.Inspect(stmt.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, name, ok := mutatingCall(pass, call)
+		if !ok {
+			return true
+		}
+		id, ok := ast.Unparen(sel.X).(*ast.Ident)
+		if !ok || pass.TypesInfo.Uses[id] != obj {
+			return true
+		}
+		var fixes []analysis.SuggestedFix
+		if key != nil && key.Name != "_" && indexable(pass, stmt.X) {
+			fixes = []analysis.SuggestedFix{{
+				Message: "Call " + name + " on the element",
+				TextEdits: []analysis.TextEdit{{
+					Pos:     id.Pos(),
+					End:     id.End(),
+					NewText: fmt.Appendf(nil, "%s[%s]", render(pass.Fset, stmt.X), key.Name),
+				}},
+			}}
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:            call.Pos(),
+			End:            call.End(),
+			Message:        fmt.Sprintf("%s called on range variable %s, which is a copy of the element", name, id.Name),
+			SuggestedFixes: fixes,
+		})
+		return true
+	})
+}
+
+// checkValueReceiver reports mutating methods called on Option
+// fields of a value receiver, which is a copy of the caller's value.
+func checkValueReceiver(pass *analysis.Pass, decl *ast.FuncDecl) {
+	if decl.Recv == nil || decl.Body == nil || len(decl.Recv.List[0].Names) == 0 {
+		return
+	}
+	recvObj := pass.TypesInfo.Defs[decl.Recv.List[0].Names[0]]
+	if recvObj == nil || isPointer(recvObj.Type()) {
+		return
+	}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, name, ok := mutatingCall(pass, call)
+		if !ok {
+			return true
+		}
+		field, ok := ast.Unparen(sel.X).(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := ast.Unparen(field.X).(*ast.Ident); ok && pass.TypesInfo.Uses[id] == recvObj {
+			pass.Reportf(call.Pos(), "%s called on %s of value receiver %s, which is a copy; use a pointer receiver",
+				name, render(pass.Fset, field), id.Name)
+		}
+		return true
+	})
+}
+
+// mutatingCall reports whether call is a call to a mutating
+// method of Option, and returns its selector and name.
+func mutatingCall(pass *analysis.Pass, call *ast.CallExpr) (*ast.SelectorExpr, string, bool) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || !mutatingMethods[fn.Name()] {
+		return nil, "", false
+	}
+	recv := fn.Signature().Recv()
+	if recv == nil || !isOptionType(recv.Type()) {
+		return nil, "", false
+	}
+	return sel, fn.Name(), true
+}
+
+// isCallTo reports whether e is a call to the named function
+// of the option package.
+func isCallTo(pass *analysis.Pass, e ast.Expr, name string) bool {
+	call, ok := ast.Unparen(e).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == optionPath && fn.Name() == name && fn.Signature().Recv() == nil
+}
+
+// optionElem returns T if t is option.Option[T].
+func optionElem(t types.Type) (types.Type, bool) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || !isOptionType(named) {
+		return nil, false
+	}
+	return named.TypeArgs().At(0), true
+}
+
+// indexable reports whether the element a range loop over x is
+// at can be written x[key]: x must be a slice, an array or a
+// pointer to an array, and be a variable or a chain of field
+// selections, so that evaluating it again has no side effects.
+func indexable(pass *analysis.Pass, x ast.Expr) bool {
+	switch t := pass.TypesInfo.TypeOf(x).Underlying().(type) {
+	case *types.Slice, *types.Array:
+	case *types.Pointer:
+		if _, ok := t.Elem().Underlying().(*types.Array); !ok {
+			return false
+		}
+	default:
+		return false
+	}
+	return isVarRef(pass, x)
+}
+
+// isVarRef reports whether e is a variable or a chain of field
+// selections on one.
+func isVarRef(pass *analysis.Pass, e ast.Expr) bool {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		_, ok := pass.TypesInfo.ObjectOf(e).(*types.Var)
+		return ok
+	case *ast.SelectorExpr:
+		if sel, ok := pass.TypesInfo.Selections[e]; ok {
+			return sel.Kind() == types.FieldVal && isVarRef(pass, e.X)
+		}
+		// A variable of another package.
+		_, ok := pass.TypesInfo.ObjectOf(e.Sel).(*types.Var)
+		return ok
+	}
+	return false
+}
+
+// isOptionType reports whether t is option.Option or a pointer to it.
+func isOptionType(t types.Type) bool {
+	return isOptionPkgType(t, "Option")
+}
+
+// isReasonedType reports whether t is option.Reasoned or a pointer to it.
+func isReasonedType(t types.Type) bool {
+	return isOptionPkgType(t, "Reasoned")
+}
+
+// isOptionPkgType reports whether t is the named generic type of
+// the option package or a pointer to it.
+func isOptionPkgType(t types.Type, name string) bool {
+	named := namedOf(t)
+	if named == nil {
+		return false
+	}
+	obj := named.Origin().Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == optionPath && obj.Name() == name
+}
+
+// isPrimary reports whether e is a primary expression, which a
+// method call can be appended to without parentheses.
+func isPrimary(e ast.Expr) bool {
+	switch e.(type) {
+	case *ast.Ident, *ast.BasicLit, *ast.CompositeLit, *ast.ParenExpr, *ast.SelectorExpr,
+		*ast.IndexExpr, *ast.IndexListExpr, *ast.SliceExpr, *ast.TypeAssertExpr, *ast.CallExpr:
+		return true
+	}
+	return false
+}
+
+// namedOf returns the named type of t or of the type t points to.
+func namedOf(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, _ := types.Unalias(t).(*types.Named)
+	return named
+}
+
+func isPointer(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Pointer)
+	return ok
+}
+
+func isBasic(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Basic)
+	return ok
+}
+
+// qualifier writes types of other packages with their package name.
+func qualifier(pass *analysis.Pass) types.Qualifier {
+	return func(p *types.Package) string {
+		if p == pass.Pkg {
+			return ""
+		}
+		return p.Name()
+	}
+}
+
+func typeString(pass *analysis.Pass, e ast.Expr) string {
+	return types.TypeString(pass.TypesInfo.TypeOf(e), qualifier(pass))
+}
+
+func render(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	format.Node(&buf, fset, n)
+	return buf.String()
+}
@@ -0,0 +1,12 @@
+package optionlint_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-option/tools/analysis/optionlint"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), optionlint.Analyzer, "a")
+}
@@ -0,0 +1,133 @@
+package a
+
+import (
+	"iter"
+	"time"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+type User struct {
+	Name string
+}
+
+func Lookup(id int) option.Option[*User] { // want `exported function Lookup uses option.Option\[\*User\]; a nil pointer inside Some is a second way to be missing, use Option\[User\] instead`
+	return option.None[*User]()
+}
+
+func Nested(o option.Option[option.Option[int]]) {} // want `exported function Nested uses option.Option\[option.Option\[int\]\]; nested Options are hard to use, flatten them with option.Flatten`
+
+func (u User) Manager() option.Option[*User] { // want `exported function Manager uses option.Option\[\*User\]`
+	return option.None[*User]()
+}
+
+func lookup(id int) option.Option[*User] {
+	return option.None[*User]()
+}
+
+type cache struct{}
+
+func (c cache) Get() option.Option[*User] {
+	return option.None[*User]()
+}
+
+func Fine(id int) option.Option[User] {
+	return option.None[User]()
+}
+
+func literals() {
+	_ = option.Option[int]{} // want `Option composite literal; use option.None\(\) to create an empty Option`
+	var users []option.Option[User]
+	users = append(users, option.Option[User]{}) // want `Option composite literal`
+	_ = users
+}
+
+func comparisons(a, b option.Option[int], t option.Option[time.Time], p *option.Option[int]) {
+	_ = a == option.None[int]()       // want `comparison with None using ==; use IsNone instead`
+	_ = option.None[int]() != a       // want `comparison with None using !=; use IsSome instead`
+	_ = t == option.None[time.Time]() // want `comparison with None using ==; use IsNone instead`
+	_ = *p == option.None[int]()      // want `comparison with None using ==; use IsNone instead`
+	_ = a == b
+	_ = t == option.Some(time.Now()) // want `comparison of option.Option\[time.Time\] using == compares more than the contained values; use IsSome, IsNone or option.Contains`
+	_ = option.Contains(a, 1)
+}
+
+func discarded(o option.Option[int], users []option.Option[int], r option.Reasoned[int]) {
+	o.Filter(func(v int) bool { return v > 0 })                    // want `result of Filter is discarded; Filter returns a new Option and does not modify its receiver`
+	o.Or(option.Some(1))                                           // want `result of Or is discarded`
+	users[0].Xor(o)                                                // want `result of Xor is discarded`
+	option.Map(o, func(v int) string { return "" })                // want `result of option.Map is discarded; option.Map returns a new Option and does not modify its arguments`
+	o.FilterBecause(func(v int) bool { return v > 0 }, "negative") // want `result of FilterBecause is discarded; FilterBecause returns a new Reasoned and does not modify its receiver`
+	r.Filter(func(v int) bool { return v > 0 })                    // want `result of Filter is discarded; Filter returns a new Reasoned`
+	r.Or(r)                                                        // want `result of Or is discarded`
+	option.MapReasoned(r, func(v int) string { return "" })        // want `result of option.MapReasoned is discarded; option.MapReasoned returns a new Reasoned`
+	o.Inspect(func(int) {})
+	o.Take()
+	o = o.Filter(func(v int) bool { return v > 0 })
+	_ = o
+	_ = r
+}
+
+func ranges(opts []option.Option[int], byName map[string]option.Option[int]) {
+	for i, o := range opts {
+		o.Insert(i) // want `Insert called on range variable o, which is a copy of the element`
+	}
+	for _, o := range opts {
+		o.Take() // want `Take called on range variable o, which is a copy of the element`
+	}
+	for k, o := range byName {
+		o.Replace(1) // want `Replace called on range variable o, which is a copy of the element`
+		_ = k
+	}
+	for i := range opts {
+		opts[i].Insert(i)
+	}
+	for _, o := range opts {
+		_ = o.IsSome()
+	}
+}
+
+type registry struct {
+	opts  []option.Option[int]
+	fixed [2]option.Option[int]
+}
+
+func rangesFixed(r *registry, arr *[2]option.Option[int]) {
+	for i, o := range r.opts {
+		o.Insert(i) // want `Insert called on range variable o, which is a copy of the element`
+	}
+	for i, o := range r.fixed {
+		o.Insert(i) // want `Insert called on range variable o, which is a copy of the element`
+	}
+	for i, o := range arr {
+		o.Insert(i) // want `Insert called on range variable o, which is a copy of the element`
+	}
+}
+
+func rangesNotFixed(seq iter.Seq2[int, option.Option[int]], get func() []option.Option[int], rs []registry) {
+	for i, o := range seq {
+		o.Insert(i) // want `Insert called on range variable o, which is a copy of the element`
+	}
+	for i, o := range get() {
+		o.Insert(i) // want `Insert called on range variable o, which is a copy of the element`
+	}
+	for i, o := range rs[0].opts {
+		o.Insert(i) // want `Insert called on range variable o, which is a copy of the element`
+	}
+}
+
+type form struct {
+	name option.Option[string]
+}
+
+func (f form) reset() {
+	f.name.Take() // want `Take called on f.name of value receiver f, which is a copy; use a pointer receiver`
+}
+
+func (f *form) clear() {
+	f.name.Take()
+}
+
+func pointers(a, b *option.Option[User]) bool {
+	return a == b || a == nil
+}
@@ -0,0 +1,37 @@
+// Package option is a stub of the option package for tests.
+package option
+
+type Option[T any] struct {
+	data     T
+	has_data bool
+}
+
+func Some[T any](data T) Option[T]                  { return Option[T]{data: data, has_data: true} }
+func None[T any]() Option[T]                        { return Option[T]{} }
+func (o Option[T]) IsSome() bool                    { return o.has_data }
+func (o Option[T]) IsNone() bool                    { return !o.has_data }
+func (o Option[T]) Filter(f func(T) bool) Option[T] { return o }
+func (o Option[T]) Or(optB Option[T]) Option[T]     { return o }
+func (o Option[T]) Xor(optB Option[T]) Option[T]    { return o }
+func (o Option[T]) Copy() Option[T]                 { return o }
+func (o Option[T]) FilterBecause(f func(T) bool, reason string) Reasoned[T] {
+	return Reasoned[T]{opt: o}
+}
+func (o Option[T]) Inspect(f func(T)) Option[T]                    { return o }
+func (o *Option[T]) Insert(value T) *T                             { *o = Some(value); return &o.data }
+func (o *Option[T]) Take() Option[T]                               { t := *o; *o = None[T](); return t }
+func (o *Option[T]) Replace(value T) Option[T]                     { t := *o; *o = Some(value); return t }
+func Map[T, U any](o Option[T], f func(T) U) Option[U]             { return None[U]() }
+func AndThen[T, U any](o Option[T], f func(T) Option[U]) Option[U] { return None[U]() }
+func Flatten[T any](o Option[Option[T]]) Option[T]                 { return o.data }
+func Contains[T comparable](o Option[T], value T) bool             { return o.has_data && o.data == value }
+
+type Reasoned[T any] struct {
+	opt    Option[T]
+	reason string
+}
+
+func (r Reasoned[T]) Filter(f func(T) bool) Reasoned[T]                       { return r }
+func (r Reasoned[T]) FilterBecause(f func(T) bool, reason string) Reasoned[T] { return r }
+func (r Reasoned[T]) Or(rB Reasoned[T]) Reasoned[T]                           { return r }
+func MapReasoned[T, U any](r Reasoned[T], f func(T) U) Reasoned[U]            { return Reasoned[U]{} }
@@ -0,0 +1,800 @@
+// Package optionmigrate defines an Analyzer that rewrites functions
+// returning a possibly nil *T to return option.Option[T], and updates
+// their callers in the same package.
+package optionmigrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const Doc = `migrate functions returning a nil *T to option.Option[T]
+
+The optionmigrate analyzer reports functions whose single result is a
+pointer and that return nil to mean "missing", and suggests a fix that
+changes them to return option.Option[T]. The fix rewrites
+
+  - return nil to return option.None[T]();
+  - return &v to return option.Some(v), and other pointers to
+    option.FromPtr;
+  - p == nil and p != nil at call sites to p.IsNone() and p.IsSome();
+  - *p and p.Field at call sites to p.Unwrap() and p.Unwrap().Field;
+  - other uses at call sites, such as returning the pointer from a
+    function that is not migrated, to p.ToPtr().
+
+A dereference outside of a nil check already panicked on nil, just as
+Unwrap panics on None; run unwrapcheck afterwards to find them.
+
+An Option holds a copy of its value, so a function is not migrated when
+a caller assigns through the result, calls a pointer method on it,
+takes its address, passes it to a pointer parameter, compares it with
+another pointer or uses it as a map key. A method is not migrated when
+its receiver type implements an interface with that method that is
+declared in or imported by the package, or converted to in it. Only
+callers in the analyzed package are updated; callers of exported
+functions in other packages must be updated by hand.
+
+Run it on one package at a time, with -fix -diff to preview the changes:
+
+	optionmigrate -fix -diff ./store
+	optionmigrate -fix ./store`
+
+// optionPath is the import path of the option package.
+const optionPath = "github.com/JustinKnueppel/go-option"
+
+var Analyzer = &analysis.Analyzer{
+	Name: "optionmigrate",
+	Doc:  Doc,
+	URL:  "https://pkg.go.dev/github.com/JustinKnueppel/go-option/tools/analysis/optionmigrate",
+	Run:  run,
+}
+
+// funcs restricts the migration to the named functions.
+var funcs string
+
+func init() {
+	Analyzer.Flags.StringVar(&funcs, "funcs", "", "comma-separated `names` of functions or Type.Method to migrate; by default every function returning *T that returns nil")
+}
+
+// migration is the rewrite of one function and its callers.
+type migration struct {
+	fn      *types.Func
+	decl    *ast.FuncDecl
+	result  *ast.StarExpr
+	blocked string
+	edits   []analysis.TextEdit
+}
+
+// use is how an expression of the migrated pointer type is used.
+type use int
+
+const (
+	useOther    use = iota // anything else, rewritten to ToPtr
+	useNilCheck            // p == nil or p != nil
+	useDeref               // *p
+	useField               // p.Field or p.Method with a value receiver
+	useReturn              // return p
+	useAssign              // p = x, for a variable
+	useStore               // v := p or var v = p
+	usePointer             // depends on p being a pointer, such as p.X = v, p.M(), m[p] or f(p)
+)
+
+type migrator struct {
+	pass       *analysis.Pass
+	parents    map[ast.Node]ast.Node
+	migrations map[*types.Func]*migration
+	vars       map[*types.Var]*variable
+}
+
+// variable is a local variable holding the result of a migrated call.
+type variable struct {
+	v         *types.Var
+	sources   map[*migration]bool
+	trackable bool
+	pointer   token.Pos
+	idents    []*ast.Ident
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if pass.Pkg.Path() == optionPath {
+		return nil, nil
+	}
+	m := &migrator{
+		pass:       pass,
+		parents:    map[ast.Node]ast.Node{},
+		migrations: map[*types.Func]*migration{},
+		vars:       map[*types.Var]*variable{},
+	}
+	for _, file := range pass.Files {
+		var stack []ast.Node
+		ast.Inspect(file, func(n ast.Node) bool {
+			if n == nil {
+				stack = stack[:len(stack)-1]
+				return true
+			}
+			if len(stack) > 0 {
+				m.parents[n] = stack[len(stack)-1]
+			}
+			stack = append(stack, n)
+			return true
+		})
+	}
+
+	m.findCandidates()
+	if len(m.migrations) == 0 {
+		return nil, nil
+	}
+	m.findVariables()
+	m.findUnmigratable()
+	m.rewrite()
+
+	for _, mig := range m.sorted() {
+		msg := fmt.Sprintf("%s returns %s, which may be nil", mig.fn.Name(), types.ExprString(mig.result))
+		if mig.blocked != "" {
+			pass.Reportf(mig.decl.Name.Pos(), "%s, but cannot be migrated to option.Option: %s", msg, mig.blocked)
+			continue
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:     mig.decl.Name.Pos(),
+			End:     mig.decl.Name.End(),
+			Message: fmt.Sprintf("%s; return option.Option[%s] instead", msg, types.ExprString(mig.result.X)),
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   fmt.Sprintf("Migrate %s to option.Option[%s]", mig.fn.Name(), types.ExprString(mig.result.X)),
+				TextEdits: mig.edits,
+			}},
+		})
+	}
+	return nil, nil
+}
+
+// findCandidates records the functions with a single unnamed pointer
+// result that return nil, or that are named by -funcs.
+func (m *migrator) findCandidates() {
+	var names []string
+	if funcs != "" {
+		names = strings.Split(funcs, ",")
+	}
+	for _, file := range m.pass.Files {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Body == nil {
+				continue
+			}
+			results := decl.Type.Results
+			if results == nil || len(results.List) != 1 || len(results.List[0].Names) != 0 {
+				continue
+			}
+			star, ok := results.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			fn := m.pass.TypesInfo.Defs[decl.Name].(*types.Func)
+			if names != nil {
+				if !slices.Contains(names, fn.Name()) && !slices.Contains(names, qualifiedName(fn)) {
+					continue
+				}
+			} else if !m.returnsNil(decl) {
+				continue
+			}
+			m.migrations[fn] = &migration{fn: fn, decl: decl, result: star}
+		}
+	}
+}
+
+// returnsNil reports whether decl has a return nil statement outside
+// of any function literal.
+func (m *migrator) returnsNil(decl *ast.FuncDecl) bool {
+	found := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) == 1 && m.pass.TypesInfo.Types[n.Results[0]].IsNil() {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// findVariables records the local variables that are assigned the
+// result of a migrated call, and whether every use of them can be
+// rewritten.
+func (m *migrator) findVariables() {
+	for _, call := range m.calls() {
+		mig := m.migrations[m.callee(call)]
+		v := m.storedIn(call)
+		if v == nil {
+			continue
+		}
+		info := m.vars[v]
+		if info == nil {
+			info = &variable{v: v, sources: map[*migration]bool{}, trackable: true}
+			m.vars[v] = info
+		}
+		info.sources[mig] = true
+	}
+
+	for id, obj := range m.pass.TypesInfo.Defs {
+		if v, ok := obj.(*types.Var); ok && m.vars[v] != nil {
+			m.vars[v].idents = append(m.vars[v].idents, id)
+			switch p := m.parents[id].(type) {
+			case *ast.AssignStmt:
+				if len(p.Lhs) != len(p.Rhs) {
+					m.vars[v].trackable = false
+				}
+			case *ast.ValueSpec:
+				if _, ok := p.Type.(*ast.StarExpr); p.Type != nil && (!ok || len(p.Names) > 1) {
+					m.vars[v].trackable = false
+				}
+			default:
+				m.vars[v].trackable = false
+			}
+		}
+	}
+	for id, obj := range m.pass.TypesInfo.Uses {
+		v, ok := obj.(*types.Var)
+		if !ok || m.vars[v] == nil {
+			continue
+		}
+		info := m.vars[v]
+		info.idents = append(info.idents, id)
+		switch m.useOf(id) {
+		case usePointer:
+			info.pointer = id.Pos()
+		case useAssign:
+			if assign := m.parents[id].(*ast.AssignStmt); len(assign.Lhs) != len(assign.Rhs) {
+				info.trackable = false
+			}
+		}
+	}
+	for _, info := range m.vars {
+		if info.v.Parent() == m.pass.Pkg.Scope() || len(info.sources) > 1 {
+			info.trackable = false
+		}
+		slices.SortFunc(info.idents, func(a, b *ast.Ident) int { return int(a.Pos() - b.Pos()) })
+	}
+}
+
+// findUnmigratable blocks the migrations whose result is used as a
+// pointer, that are used as function values or that implement a
+// method of an interface.
+func (m *migrator) findUnmigratable() {
+	interfaces := m.interfaces()
+	for _, mig := range m.migrations {
+		if iface := implemented(mig.fn, interfaces); iface != nil {
+			mig.block("it implements a method of the interface " + types.TypeString(iface, types.RelativeTo(m.pass.Pkg)))
+		}
+	}
+	for _, info := range m.vars {
+		if info.pointer == token.NoPos {
+			continue
+		}
+		for mig := range info.sources {
+			mig.block(fmt.Sprintf("%s is used as a pointer at %s", info.v.Name(), m.position(info.pointer)))
+		}
+	}
+	for _, call := range m.calls() {
+		if m.useOf(call) == usePointer {
+			m.migrations[m.callee(call)].block(fmt.Sprintf("the result is used as a pointer at %s", m.position(call.Pos())))
+		}
+	}
+	for id, obj := range m.pass.TypesInfo.Uses {
+		fn, ok := obj.(*types.Func)
+		if !ok || m.migrations[fn.Origin()] == nil {
+			continue
+		}
+		fn = fn.Origin()
+		var fun ast.Node = id
+		if sel, ok := m.parents[id].(*ast.SelectorExpr); ok && sel.Sel == id {
+			fun = sel
+		}
+		if call, ok := m.parents[fun].(*ast.CallExpr); !ok || call.Fun != fun {
+			m.migrations[fn].block(fmt.Sprintf("it is used as a function value at %s", m.position(id.Pos())))
+		}
+	}
+}
+
+// interfaces returns the interface types declared in the package and
+// the packages it imports, followed by the other interface types used
+// in the package, such as those its values are converted to.
+func (m *migrator) interfaces() []types.Type {
+	var named, other []types.Type
+	for _, pkg := range append([]*types.Package{m.pass.Pkg}, m.pass.Pkg.Imports()...) {
+		for _, name := range pkg.Scope().Names() {
+			obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+			if !ok || !types.IsInterface(obj.Type()) {
+				continue
+			}
+			// A generic interface is only implemented once instantiated.
+			if t, ok := types.Unalias(obj.Type()).(*types.Named); ok && t.TypeParams().Len() > 0 {
+				continue
+			}
+			named = append(named, obj.Type())
+		}
+	}
+	seen := map[string]bool{}
+	for _, tv := range m.pass.TypesInfo.Types {
+		if tv.Type == nil || !types.IsInterface(tv.Type) || seen[tv.Type.String()] {
+			continue
+		}
+		seen[tv.Type.String()] = true
+		other = append(other, tv.Type)
+	}
+	slices.SortFunc(other, func(a, b types.Type) int { return strings.Compare(a.String(), b.String()) })
+	return append(named, other...)
+}
+
+// implemented returns the first of interfaces that has a method
+// named like fn and is implemented by its receiver type, or nil if
+// fn is not a method.
+func implemented(fn *types.Func, interfaces []types.Type) types.Type {
+	recv := fn.Signature().Recv()
+	if recv == nil {
+		return nil
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	for _, iface := range interfaces {
+		it := iface.Underlying().(*types.Interface)
+		if !it.IsMethodSet() {
+			continue
+		}
+		if obj, _, _ := types.LookupFieldOrMethod(it, false, fn.Pkg(), fn.Name()); obj == nil {
+			continue
+		}
+		if types.Implements(t, it) || types.Implements(types.NewPointer(t), it) {
+			return iface
+		}
+	}
+	return nil
+}
+
+func (mig *migration) block(reason string) {
+	if mig.blocked == "" {
+		mig.blocked = reason
+	}
+}
+
+// rewrite computes the edits of every migration that is not blocked.
+func (m *migrator) rewrite() {
+	for _, mig := range m.migrations {
+		if mig.blocked != "" {
+			continue
+		}
+		file := m.file(mig.decl.Pos())
+		mig.edit(mig.result.Pos(), mig.result.End(), fmt.Sprintf("%s.Option[%s]", m.optionName(file, mig), types.ExprString(mig.result.X)))
+		ast.Inspect(mig.decl.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ReturnStmt:
+				if len(n.Results) == 1 {
+					m.wrap(mig, n.Results[0], mig.result.X)
+				}
+			}
+			return true
+		})
+	}
+
+	for _, call := range m.calls() {
+		mig := m.migrations[m.callee(call)]
+		if mig.blocked != "" {
+			continue
+		}
+		if v := m.storedIn(call); v != nil && m.vars[v].trackable {
+			continue
+		}
+		m.rewriteUse(mig, call)
+	}
+
+	for _, info := range m.vars {
+		if !info.trackable {
+			continue
+		}
+		mig := info.source()
+		if mig.blocked != "" {
+			continue
+		}
+		for _, id := range info.idents {
+			if m.pass.TypesInfo.Defs[id] == nil {
+				m.rewriteUse(mig, id)
+				continue
+			}
+			switch p := m.parents[id].(type) {
+			case *ast.AssignStmt:
+				m.wrap(mig, p.Rhs[slices.Index(p.Lhs, ast.Expr(id))], mig.result.X)
+			case *ast.ValueSpec:
+				if p.Type != nil {
+					star := p.Type.(*ast.StarExpr)
+					mig.edit(star.Pos(), star.End(), fmt.Sprintf("%s.Option[%s]", m.optionName(m.file(id.Pos()), mig), types.ExprString(star.X)))
+				}
+				if len(p.Values) > 0 {
+					m.wrap(mig, p.Values[slices.Index(p.Names, id)], mig.result.X)
+				}
+			}
+		}
+	}
+}
+
+// source returns the migrated function whose result v holds.
+func (v *variable) source() *migration {
+	for mig := range v.sources {
+		return mig
+	}
+	return nil
+}
+
+// rewriteUse rewrites a use of e, which was a *T and is now an
+// option.Option[T].
+func (m *migrator) rewriteUse(mig *migration, e ast.Expr) {
+	switch m.useOf(e) {
+	case useNilCheck:
+		cmp := m.parents[e].(*ast.BinaryExpr)
+		method := ".IsNone()"
+		if cmp.Op == token.NEQ {
+			method = ".IsSome()"
+		}
+		if cmp.X == e {
+			mig.edit(e.End(), cmp.End(), method)
+		} else {
+			mig.edit(cmp.Pos(), e.Pos(), "")
+			mig.edit(e.End(), e.End(), method)
+		}
+	case useDeref:
+		star := m.parents[e].(*ast.StarExpr)
+		mig.edit(star.Pos(), e.Pos(), "")
+		mig.edit(e.End(), e.End(), ".Unwrap()")
+	case useField:
+		mig.edit(e.End(), e.End(), ".Unwrap()")
+	case useReturn:
+		if fn := m.enclosingFunc(e); fn == nil || m.migrations[fn] == nil || m.migrations[fn].blocked != "" {
+			mig.edit(e.End(), e.End(), ".ToPtr()")
+		}
+	case useAssign:
+		assign := m.parents[e].(*ast.AssignStmt)
+		i := slices.Index(assign.Lhs, e)
+		m.wrap(mig, assign.Rhs[i], mig.result.X)
+	case useStore, useOther:
+		mig.edit(e.End(), e.End(), ".ToPtr()")
+	}
+}
+
+// wrap rewrites e, a *T, to an option.Option[T].
+func (m *migrator) wrap(mig *migration, e ast.Expr, elem ast.Expr) {
+	name := m.optionName(m.file(e.Pos()), mig)
+	switch {
+	case m.pass.TypesInfo.Types[e].IsNil():
+		mig.edit(e.Pos(), e.End(), fmt.Sprintf("%s.None[%s]()", name, types.ExprString(elem)))
+		return
+	case m.isOption(e):
+		return
+	}
+	if addr, ok := e.(*ast.UnaryExpr); ok && addr.Op == token.AND {
+		mig.edit(addr.Pos(), addr.X.Pos(), name+".Some(")
+		mig.edit(addr.End(), addr.End(), ")")
+		return
+	}
+	mig.edit(e.Pos(), e.Pos(), name+".FromPtr(")
+	mig.edit(e.End(), e.End(), ")")
+}
+
+// isOption reports whether e will be an option.Option after the
+// migration: a call to a migrated function or a tracked variable.
+func (m *migrator) isOption(e ast.Expr) bool {
+	switch e := e.(type) {
+	case *ast.CallExpr:
+		mig := m.migrations[m.callee(e)]
+		return mig != nil && mig.blocked == ""
+	case *ast.Ident:
+		v, _ := m.pass.TypesInfo.Uses[e].(*types.Var)
+		info := m.vars[v]
+		return info != nil && info.trackable && info.source().blocked == ""
+	}
+	return false
+}
+
+// useOf classifies how the value of e is used.
+func (m *migrator) useOf(e ast.Expr) use {
+	switch p := m.parents[e].(type) {
+	case *ast.BinaryExpr:
+		other := p.Y
+		if p.Y == e {
+			other = p.X
+		}
+		if p.Op == token.EQL || p.Op == token.NEQ {
+			if m.pass.TypesInfo.Types[other].IsNil() {
+				return useNilCheck
+			}
+			return usePointer
+		}
+	case *ast.StarExpr:
+		if m.isLvalue(m.chainTop(p)) {
+			return usePointer
+		}
+		return useDeref
+	case *ast.SelectorExpr:
+		sel := m.pass.TypesInfo.Selections[p]
+		if sel == nil || p.X != e {
+			break
+		}
+		switch sel.Kind() {
+		case types.FieldVal:
+			top := m.chainTop(p)
+			if m.isLvalue(top) || m.callsPointerMethod(top) {
+				return usePointer
+			}
+			return useField
+		case types.MethodVal:
+			if _, ptr := sel.Obj().(*types.Func).Signature().Recv().Type().(*types.Pointer); ptr {
+				return usePointer
+			}
+			return useField
+		}
+	case *ast.UnaryExpr:
+		if p.Op == token.AND {
+			return usePointer
+		}
+	case *ast.IndexExpr:
+		if _, isMap := m.pass.TypesInfo.TypeOf(p.X).Underlying().(*types.Map); isMap && p.Index == e {
+			return usePointer
+		}
+	case *ast.CallExpr:
+		// The callee may modify the value through the pointer or
+		// keep it, and ToPtr would give it a copy.
+		if i := slices.Index(p.Args, e); i >= 0 && isPointer(m.paramType(p, i)) {
+			return usePointer
+		}
+	case *ast.ReturnStmt:
+		return useReturn
+	case *ast.AssignStmt:
+		if slices.Contains(p.Lhs, e) {
+			return useAssign
+		}
+		if len(p.Lhs) == len(p.Rhs) {
+			return useStore
+		}
+	case *ast.ValueSpec:
+		if len(p.Names) == len(p.Values) {
+			return useStore
+		}
+	}
+	return useOther
+}
+
+// paramType returns the type of the parameter that the i-th argument
+// of call is passed to, or nil if call is not a function call.
+func (m *migrator) paramType(call *ast.CallExpr, i int) types.Type {
+	sig, ok := m.pass.TypesInfo.TypeOf(call.Fun).Underlying().(*types.Signature)
+	if !ok {
+		return nil
+	}
+	params := sig.Params()
+	if sig.Variadic() && i >= params.Len()-1 {
+		last := params.At(params.Len() - 1).Type()
+		if call.Ellipsis.IsValid() {
+			return last
+		}
+		return last.(*types.Slice).Elem()
+	}
+	if i >= params.Len() {
+		return nil
+	}
+	return params.At(i).Type()
+}
+
+// chainTop returns the outermost selector, index or dereference
+// expression that has n as its base.
+func (m *migrator) chainTop(n ast.Expr) ast.Expr {
+	for {
+		switch p := m.parents[n].(type) {
+		case *ast.SelectorExpr:
+			if sel := m.pass.TypesInfo.Selections[p]; sel == nil || sel.Kind() != types.FieldVal {
+				return n
+			}
+		case *ast.IndexExpr:
+			if p.X != n {
+				return n
+			}
+		case *ast.StarExpr, *ast.ParenExpr:
+		default:
+			return n
+		}
+		n = m.parents[n].(ast.Expr)
+	}
+}
+
+// isLvalue reports whether n is assigned to, incremented or has its
+// address taken.
+func (m *migrator) isLvalue(n ast.Expr) bool {
+	switch p := m.parents[n].(type) {
+	case *ast.AssignStmt:
+		return slices.Contains(p.Lhs, n)
+	case *ast.IncDecStmt:
+		return true
+	case *ast.UnaryExpr:
+		return p.Op == token.AND
+	case *ast.RangeStmt:
+		return p.Key == n || p.Value == n
+	}
+	return false
+}
+
+// callsPointerMethod reports whether n is the receiver of a pointer
+// method, which may modify it.
+func (m *migrator) callsPointerMethod(n ast.Expr) bool {
+	p, ok := m.parents[n].(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	sel := m.pass.TypesInfo.Selections[p]
+	if sel == nil || sel.Kind() != types.MethodVal {
+		return false
+	}
+	_, ptr := sel.Obj().(*types.Func).Signature().Recv().Type().(*types.Pointer)
+	return ptr && !sel.Indirect()
+}
+
+// storedIn returns the local variable that the result of call is
+// assigned to, if any.
+func (m *migrator) storedIn(call *ast.CallExpr) *types.Var {
+	var id *ast.Ident
+	switch p := m.parents[call].(type) {
+	case *ast.AssignStmt:
+		if i := slices.Index(p.Rhs, ast.Expr(call)); i >= 0 && len(p.Lhs) == len(p.Rhs) {
+			id, _ = p.Lhs[i].(*ast.Ident)
+		}
+	case *ast.ValueSpec:
+		if i := slices.Index(p.Values, ast.Expr(call)); i >= 0 && len(p.Names) == len(p.Values) {
+			id = p.Names[i]
+		}
+	}
+	if id == nil {
+		return nil
+	}
+	v, _ := m.pass.TypesInfo.ObjectOf(id).(*types.Var)
+	return v
+}
+
+// calls returns the calls to migrated functions in source order.
+func (m *migrator) calls() []*ast.CallExpr {
+	var calls []*ast.CallExpr
+	for n := range m.parents {
+		if call, ok := n.(*ast.CallExpr); ok && m.migrations[m.callee(call)] != nil {
+			calls = append(calls, call)
+		}
+	}
+	slices.SortFunc(calls, func(a, b *ast.CallExpr) int { return int(a.Pos() - b.Pos()) })
+	return calls
+}
+
+func (m *migrator) callee(call *ast.CallExpr) *types.Func {
+	fn, _ := typeutil.Callee(m.pass.TypesInfo, call).(*types.Func)
+	if fn == nil {
+		return nil
+	}
+	return fn.Origin()
+}
+
+// enclosingFunc returns the function declaration that n is directly
+// in, or nil if n is in a function literal.
+func (m *migrator) enclosingFunc(n ast.Node) *types.Func {
+	for ; n != nil; n = m.parents[n] {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return nil
+		case *ast.FuncDecl:
+			fn, _ := m.pass.TypesInfo.Defs[n.Name].(*types.Func)
+			return fn
+		}
+	}
+	return nil
+}
+
+// optionName returns the name the option package is imported as in
+// file, adding the import to mig if it is missing.
+func (m *migrator) optionName(file *ast.File, mig *migration) string {
+	for _, spec := range file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == optionPath {
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+			return "option"
+		}
+	}
+	path := strconv.Quote(optionPath)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() {
+			mig.edit(gen.Rparen, gen.Rparen, "\n\t"+path+"\n")
+		} else {
+			spec := gen.Specs[0]
+			mig.edit(spec.Pos(), spec.Pos(), "(\n\t")
+			mig.edit(spec.End(), spec.End(), "\n\n\t"+path+"\n)")
+		}
+		return "option"
+	}
+	mig.edit(file.Name.End(), file.Name.End(), "\n\nimport "+path)
+	return "option"
+}
+
+func (mig *migration) edit(pos, end token.Pos, text string) {
+	edit := analysis.TextEdit{Pos: pos, End: end, NewText: []byte(text)}
+	for _, e := range mig.edits {
+		if e.Pos == edit.Pos && e.End == edit.End && bytes.Equal(e.NewText, edit.NewText) {
+			return
+		}
+	}
+	mig.edits = append(mig.edits, edit)
+}
+
+func (m *migrator) file(pos token.Pos) *ast.File {
+	for _, file := range m.pass.Files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+	return nil
+}
+
+func (m *migrator) position(pos token.Pos) string {
+	p := m.pass.Fset.Position(pos)
+	return fmt.Sprintf("%s:%d:%d", shortName(p.Filename), p.Line, p.Column)
+}
+
+func (m *migrator) sorted() []*migration {
+	var migrations []*migration
+	for _, mig := range m.migrations {
+		migrations = append(migrations, mig)
+	}
+	slices.SortFunc(migrations, func(a, b *migration) int { return int(a.decl.Pos() - b.decl.Pos()) })
+	return migrations
+}
+
+// qualifiedName returns Type.Method for methods and the name for
+// functions.
+func qualifiedName(fn *types.Func) string {
+	recv := fn.Signature().Recv()
+	if recv == nil {
+		return fn.Name()
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := types.Unalias(t).(*types.Named); ok {
+		return named.Obj().Name() + "." + fn.Name()
+	}
+	return fn.Name()
+}
+
+func shortName(path string) string {
+	if i := strings.LastIndexAny(path, `/\`); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
+
+func isPointer(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	_, ok := t.Underlying().(*types.Pointer)
+	return ok
+}
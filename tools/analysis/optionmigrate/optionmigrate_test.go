@@ -0,0 +1,19 @@
+package optionmigrate_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-option/tools/analysis/optionmigrate"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), optionmigrate.Analyzer, "a")
+}
+func TestFuncs(t *testing.T) {
+	flag := optionmigrate.Analyzer.Flags.Lookup("funcs")
+	flag.Value.Set("Store.Get,Default")
+	defer flag.Value.Set("")
+
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), optionmigrate.Analyzer, "b")
+}
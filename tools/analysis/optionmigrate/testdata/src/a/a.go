@@ -0,0 +1,133 @@
+package a
+
+import "fmt"
+
+type User struct {
+	Name string
+}
+
+func (u *User) Rename(name string) { u.Name = name }
+
+func (u User) Greeting() string { return "Hello, " + u.Name }
+
+var users = map[int]*User{}
+
+func FindUser(id int) *User { // want `FindUser returns \*User, which may be nil; return option.Option\[User\] instead`
+	if id < 0 {
+		return nil
+	}
+	if u, ok := users[id]; ok {
+		return u
+	}
+	return &User{Name: fmt.Sprint(id)}
+}
+
+func Name(id int) string {
+	u := FindUser(id)
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func Print(id int) {
+	if p := FindUser(id); p != nil {
+		fmt.Println(*p, p.Greeting())
+	}
+	if FindUser(id) == nil {
+		fmt.Println("missing")
+	}
+	fmt.Println(FindUser(id))
+}
+
+func Declared(id int) string {
+	var u *User = FindUser(id)
+	if nil != u {
+		return u.Name
+	}
+	return ""
+}
+
+func show(u *User) {}
+
+func Owner(id int) *User { // want `Owner returns \*User, which may be nil; return option.Option\[User\] instead`
+	if id == 0 {
+		return nil
+	}
+	u := FindUser(id)
+	return u
+}
+
+func Admin() *User {
+	return FindUser(1)
+}
+
+type Session struct {
+	user *User
+}
+
+func (s *Session) Current() *User { // want `Current returns \*User, which may be nil, but cannot be migrated to option.Option: the result is used as a pointer at a.go:\d+:\d+`
+	if s == nil {
+		return nil
+	}
+	return s.user
+}
+
+func Logout(s *Session) {
+	s.Current().Name = ""
+}
+
+func Guest() *User { // want `Guest returns \*User, which may be nil, but cannot be migrated to option.Option: it is used as a function value at a.go:\d+:\d+`
+	return nil
+}
+
+var defaultUser = Guest
+
+func Same(a, b int) bool {
+	return Cached(a) == Cached(b)
+}
+
+func Cached(id int) *User { // want `Cached returns \*User, which may be nil, but cannot be migrated to option.Option: the result is used as a pointer at a.go:\d+:\d+`
+	return nil
+}
+
+func Touch(id int) {
+	u := Lookup(id)
+	if u != nil {
+		u.Name += "!"
+	}
+}
+
+func Lookup(id int) *User { // want `Lookup returns \*User, which may be nil, but cannot be migrated to option.Option: u is used as a pointer at a.go:\d+:\d+`
+	return nil
+}
+
+func New(name string) *User {
+	return &User{Name: name}
+}
+
+var visits = map[*User]int{}
+
+func Visit(id int) {
+	visits[Resolve(id)]++
+}
+
+func Resolve(id int) *User { // want `Resolve returns \*User, which may be nil, but cannot be migrated to option.Option: the result is used as a pointer at a.go:\d+:\d+`
+	return nil
+}
+
+func Promote(id int) {
+	Member(id).Rename("admin")
+}
+
+func Member(id int) *User { // want `Member returns \*User, which may be nil, but cannot be migrated to option.Option: the result is used as a pointer at a.go:\d+:\d+`
+	return nil
+}
+
+func Notify(id int) {
+	show(Recipient(id))
+}
+
+func Recipient(id int) *User { // want `Recipient returns \*User, which may be nil, but cannot be migrated to option.Option: the result is used as a pointer at a.go:\d+:\d+`
+	return nil
+}
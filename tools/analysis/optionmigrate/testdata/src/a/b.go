@@ -0,0 +1,13 @@
+package a
+
+func Exists(id int) bool {
+	return FindUser(id) != nil
+}
+
+func Upper(id int) *User {
+	var u *User = FindUser(id)
+	if u != nil && u.Name == "" {
+		u = nil
+	}
+	return u
+}
@@ -0,0 +1,17 @@
+package a
+
+import (
+	"strings"
+)
+
+func Initials(ids []int) string {
+	var b strings.Builder
+	for _, id := range ids {
+		var u *User
+		u = Owner(id)
+		if u != nil {
+			b.WriteString(u.Name[:1])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,40 @@
+package a
+
+type Finder interface {
+	Find(id int) *User
+}
+
+type store struct {
+	users map[int]*User
+}
+
+func (s store) Find(id int) *User { // want `Find returns \*User, which may be nil, but cannot be migrated to option.Option: it implements a method of the interface Finder`
+	if u, ok := s.users[id]; ok {
+		return u
+	}
+	return nil
+}
+
+var _ Finder = store{}
+
+type cache struct {
+	users map[int]*User
+}
+
+func (c *cache) Get(id int) *User { // want `Get returns \*User, which may be nil, but cannot be migrated to option.Option: it implements a method of the interface interface\{Get\(int\) \*User\}`
+	if u, ok := c.users[id]; ok {
+		return u
+	}
+	return nil
+}
+
+func (c *cache) Peek(id int) *User { // want `Peek returns \*User, which may be nil; return option.Option\[User\] instead`
+	if u, ok := c.users[id]; ok {
+		return u
+	}
+	return nil
+}
+
+func Getter(c *cache) any {
+	return interface{ Get(int) *User }(c)
+}
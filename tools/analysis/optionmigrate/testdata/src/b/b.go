@@ -0,0 +1,25 @@
+package b
+
+type User struct {
+	Name string
+}
+
+type Store struct {
+	users map[string]User
+}
+
+func (s *Store) Get(name string) *User { // want `Get returns \*User, which may be nil; return option.Option\[User\] instead`
+	u, ok := s.users[name]
+	if !ok {
+		return nil
+	}
+	return &u
+}
+
+func Find(name string) *User {
+	return nil
+}
+
+func Default() *User { // want `Default returns \*User, which may be nil; return option.Option\[User\] instead`
+	return &User{Name: "guest"}
+}
@@ -0,0 +1,27 @@
+// Package option is a stub of the option package for tests.
+package option
+
+type Option[T any] struct {
+	data     T
+	has_data bool
+}
+
+func Some[T any](data T) Option[T] { return Option[T]{data: data, has_data: true} }
+func None[T any]() Option[T]       { return Option[T]{} }
+func (o Option[T]) IsSome() bool   { return o.has_data }
+func (o Option[T]) IsNone() bool   { return !o.has_data }
+func (o Option[T]) Unwrap() T      { return o.data }
+
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+func (o Option[T]) ToPtr() *T {
+	if !o.has_data {
+		return nil
+	}
+	return &o.data
+}
@@ -0,0 +1,358 @@
+package a
+
+import (
+	"log"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func get() option.Option[int] {
+	return option.None[int]()
+}
+
+type user struct {
+	email option.Option[string]
+}
+
+func unguarded(o option.Option[int]) int {
+	return o.Unwrap() // want `call to o.Unwrap is not guarded by an IsSome or IsNone check`
+}
+
+func unguardedExpect(o option.Option[int]) int {
+	return o.Expect("missing") // want `call to o.Expect is not guarded by an IsSome or IsNone check`
+}
+
+func unguardedCall() int {
+	return get().Unwrap() // want `call to get\(\).Unwrap is not guarded`
+}
+
+func ifSome(o option.Option[int]) int {
+	if o.IsSome() {
+		return o.Unwrap()
+	}
+	return 0
+}
+
+func ifSomeAnd(o option.Option[int]) int {
+	if o.IsSomeAnd(func(x int) bool { return x > 0 }) {
+		return o.Unwrap()
+	}
+	return 0
+}
+
+func ifNoneElse(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	} else {
+		return o.Unwrap()
+	}
+}
+
+func ifNotSome(o option.Option[int]) int {
+	if !o.IsSome() {
+		return 0
+	}
+	return o.Unwrap()
+}
+
+func earlyReturn(o option.Option[int]) int {
+	if o.IsNone() {
+		log.Println("missing")
+		return 0
+	}
+	return o.Unwrap()
+}
+
+func earlyPanic(o option.Option[int]) int {
+	if o.IsNone() {
+		panic("missing")
+	}
+	return o.Expect("checked")
+}
+
+func earlyFatal(o option.Option[int]) int {
+	if o.IsNone() {
+		log.Fatal("missing")
+	}
+	return o.Unwrap()
+}
+
+func earlyContinue(os []option.Option[int]) int {
+	sum := 0
+	for _, o := range os {
+		if o.IsNone() {
+			continue
+		}
+		sum += o.Unwrap()
+	}
+	return sum
+}
+
+func noExit(o option.Option[int]) int {
+	if o.IsNone() {
+		log.Println("missing")
+	}
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func wrongVariable(o, p option.Option[int]) int {
+	if o.IsSome() {
+		return p.Unwrap() // want `call to p.Unwrap is not guarded`
+	}
+	return 0
+}
+
+func wrongBranch(o option.Option[int]) int {
+	if o.IsNone() {
+		return o.Unwrap() // want `call to o.Unwrap is not guarded`
+	}
+	return 0
+}
+
+func conjunction(o option.Option[int]) bool {
+	return o.IsSome() && o.Unwrap() > 0
+}
+
+func disjunction(o option.Option[int]) bool {
+	return o.IsNone() || o.Unwrap() > 0
+}
+
+func bothSome(o, p option.Option[int]) int {
+	if o.IsSome() && p.IsSome() {
+		return o.Unwrap() + p.Unwrap()
+	}
+	return 0
+}
+
+func eitherNone(o, p option.Option[int]) int {
+	if o.IsNone() || p.IsNone() {
+		return 0
+	}
+	return o.Unwrap() + p.Unwrap()
+}
+
+func oneOfSome(o, p option.Option[int]) int {
+	if o.IsSome() || p.IsSome() {
+		return o.Unwrap() // want `call to o.Unwrap is not guarded`
+	}
+	return 0
+}
+
+func reassigned(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	o = get()
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func assignedSome() int {
+	o := option.Some(1)
+	return o.Unwrap()
+}
+
+func taken(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	o.Take()
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func inserted(o option.Option[int]) int {
+	o.Insert(1)
+	return o.Unwrap()
+}
+
+func field(u user) string {
+	if u.email.IsSome() {
+		return u.email.Unwrap()
+	}
+	return u.email.Unwrap() // want `call to u.email.Unwrap is not guarded`
+}
+
+func pointer(o *option.Option[int]) int {
+	if o.IsSome() {
+		return o.Unwrap()
+	}
+	return 0
+}
+
+func closure(o option.Option[int]) func() int {
+	if o.IsSome() {
+		return func() int { return o.Unwrap() }
+	}
+	return func() int { return o.Unwrap() } // want `call to o.Unwrap is not guarded`
+}
+
+func switchCase(o option.Option[int]) int {
+	switch {
+	case o.IsSome():
+		return o.Unwrap()
+	default:
+		return o.Unwrap() // want `call to o.Unwrap is not guarded`
+	}
+}
+
+func forCondition(o option.Option[int]) int {
+	sum := 0
+	for o.IsSome() {
+		sum += o.Unwrap()
+		o = option.None[int]()
+	}
+	return sum
+}
+
+func ifInit() int {
+	if o := get(); o.IsSome() {
+		return o.Unwrap()
+	}
+	return 0
+}
+
+func shadowed(o option.Option[int]) int {
+	if o.IsSome() {
+		o := get()
+		return o.Unwrap() // want `call to o.Unwrap is not guarded`
+	}
+	return 0
+}
+
+func takenInExpression(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	v := o.Take().UnwrapOrDefault()
+	return v + o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func scannedInIf(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	if err := o.Scan(nil); err != nil {
+		return 0
+	}
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func reset(o *option.Option[int]) {
+	*o = option.None[int]()
+}
+
+func addressTaken(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	reset(&o)
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func methodValue(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	take := o.Take
+	take()
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func modifiedInBranch(o option.Option[int], b bool) int {
+	if o.IsNone() {
+		return 0
+	}
+	if b {
+		o = get()
+	}
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func parentReassigned(u, v user) string {
+	if u.email.IsNone() {
+		return ""
+	}
+	u = v
+	return u.email.Unwrap() // want `call to u.email.Unwrap is not guarded`
+}
+
+func loopBackEdge(o option.Option[int], n int) int {
+	if o.IsNone() {
+		return 0
+	}
+	sum := 0
+	for i := 0; i < n; i++ {
+		sum += o.Unwrap() // want `call to o.Unwrap is not guarded`
+		o = option.None[int]()
+	}
+	return sum
+}
+
+func rangeBackEdge(o option.Option[int], xs []int) int {
+	if o.IsNone() {
+		return 0
+	}
+	sum := 0
+	for range xs {
+		sum += o.Unwrap() // want `call to o.Unwrap is not guarded`
+		o.Take()
+	}
+	return sum
+}
+
+func loopUnmodified(o option.Option[int], xs []int) int {
+	if o.IsNone() {
+		return 0
+	}
+	sum := 0
+	for range xs {
+		sum += o.Unwrap()
+	}
+	return sum
+}
+
+func escapingClosure(o option.Option[int]) func() int {
+	if o.IsNone() {
+		return nil
+	}
+	f := func() int { return o.Unwrap() } // want `call to o.Unwrap is not guarded`
+	o = option.None[int]()
+	return f
+}
+
+func gotoForward(o option.Option[int]) int {
+	if o.IsNone() {
+		goto end
+	}
+	return o.Unwrap()
+end:
+	return o.Unwrap() // want `call to o.Unwrap is not guarded`
+}
+
+func gotoBackward(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	n := 0
+again:
+	n += o.Unwrap() // want `call to o.Unwrap is not guarded`
+	o = option.None[int]()
+	if n < 10 {
+		goto again
+	}
+	return n
+}
+
+func labeledLoop(o option.Option[int], xs [][]int) int {
+	if o.IsNone() {
+		return 0
+	}
+	sum := 0
+outer:
+	for _, x := range xs {
+		for range x {
+			sum += o.Unwrap()
+			continue outer
+		}
+	}
+	return sum
+}
@@ -0,0 +1,19 @@
+// Package option is a stub of the option package for tests.
+package option
+
+type Option[T any] struct {
+	data     T
+	has_data bool
+}
+
+func Some[T any](data T) Option[T]                { return Option[T]{data: data, has_data: true} }
+func None[T any]() Option[T]                      { return Option[T]{} }
+func (o Option[T]) IsSome() bool                  { return o.has_data }
+func (o Option[T]) IsSomeAnd(f func(T) bool) bool { return o.has_data && f(o.data) }
+func (o Option[T]) IsNone() bool                  { return !o.has_data }
+func (o Option[T]) Expect(msg string) T           { return o.data }
+func (o Option[T]) Unwrap() T                     { return o.data }
+func (o Option[T]) UnwrapOrDefault() T            { return o.data }
+func (o *Option[T]) Insert(value T) *T            { *o = Some(value); return &o.data }
+func (o *Option[T]) Take() Option[T]              { t := *o; *o = None[T](); return t }
+func (o *Option[T]) Scan(src any) error           { return nil }
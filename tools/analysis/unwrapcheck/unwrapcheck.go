@@ -0,0 +1,540 @@
+// Package unwrapcheck defines an Analyzer that reports calls to
+// Option.Unwrap and Option.Expect that are not guarded by an
+// IsSome or IsNone check on the same value.
+package unwrapcheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const Doc = `check for unguarded calls to Option.Unwrap and Option.Expect
+
+Unwrap and Expect panic when called on a None value. The unwrapcheck
+analyzer reports calls to them that are not dominated by an IsSome or
+IsNone check on the same variable, such as
+
+	if opt.IsSome() {
+		v := opt.Unwrap()
+	}
+
+or an early exit like
+
+	if opt.IsNone() {
+		return
+	}
+	v := opt.Unwrap()
+
+A guard no longer holds once the value may have changed: after an
+assignment to it or to a value containing it, a call to a pointer
+method such as Take, or taking its address. Inside a loop or a function
+literal, a guard only holds if the value is not changed elsewhere in
+the loop or function, and no guard holds at a label that a goto
+jumps to.
+
+Unguarded calls should usually be replaced by UnwrapOr, UnwrapOrElse,
+UnwrapOrDefault or option.MapOr, which never panic.`
+
+// optionPath is the import path of the option package.
+const optionPath = "github.com/JustinKnueppel/go-option"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "unwrapcheck",
+	Doc:      Doc,
+	URL:      "https://pkg.go.dev/github.com/JustinKnueppel/go-option/tools/analysis/unwrapcheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	c := &checker{pass: pass}
+
+	nodeFilter := []ast.Node{(*ast.FuncDecl)(nil)}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		if body := n.(*ast.FuncDecl).Body; body != nil {
+			c.funcModified = c.modified(body)
+			c.gotoLabels = gotoLabels(body)
+			c.stmts(body.List, guards{})
+		}
+	})
+	return nil, nil
+}
+
+// guards is the set of values known to be `Some` at a point in
+// a function, keyed by the result of checker.key.
+type guards map[string]bool
+
+// with returns a copy of g that also contains keys.
+func (g guards) with(keys []string) guards {
+	if len(keys) == 0 {
+		return g
+	}
+	result := maps.Clone(g)
+	for _, k := range keys {
+		result[k] = true
+	}
+	return result
+}
+
+// without returns a copy of g that does not contain keys, or
+// the keys of fields of the values they identify.
+func (g guards) without(keys ...string) guards {
+	var result guards
+	for k := range g {
+		for _, key := range keys {
+			if k == key || strings.HasPrefix(k, key+".") {
+				if result == nil {
+					result = maps.Clone(g)
+				}
+				delete(result, k)
+			}
+		}
+	}
+	if result == nil {
+		return g
+	}
+	return result
+}
+
+type checker struct {
+	pass *analysis.Pass
+	// funcModified are the keys of the values modified anywhere
+	// in the function being checked.
+	funcModified []string
+	// gotoLabels are the labels that goto statements in the
+	// function being checked jump to.
+	gotoLabels map[string]bool
+}
+
+// gotoLabels returns the labels that goto statements in body
+// jump to.
+func gotoLabels(body *ast.BlockStmt) map[string]bool {
+	labels := make(map[string]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		if s, ok := n.(*ast.BranchStmt); ok && s.Tok == token.GOTO {
+			labels[s.Label.Name] = true
+		}
+		return true
+	})
+	return labels
+}
+
+// stmts checks a list of statements in order. A statement that
+// exits early when a value is `None` guards the statements after
+// it, and an assignment to a value removes its guard. A goto
+// may jump to a label from anywhere, so no guard holds there.
+func (c *checker) stmts(list []ast.Stmt, g guards) {
+	for _, s := range list {
+		if s, ok := s.(*ast.LabeledStmt); ok && c.gotoLabels[s.Label.Name] {
+			g = guards{}
+		}
+		c.stmt(s, g)
+		g = c.after(s, g)
+	}
+}
+
+// after returns the guards that hold after s has run.
+func (c *checker) after(s ast.Stmt, g guards) guards {
+	switch s := s.(type) {
+	case *ast.AssignStmt:
+		for _, rhs := range s.Rhs {
+			g = g.without(c.modified(rhs)...)
+		}
+		for i, lhs := range s.Lhs {
+			k, ok := c.key(lhs)
+			if !ok {
+				continue
+			}
+			if len(s.Lhs) == len(s.Rhs) && c.isSomeCall(s.Rhs[i]) {
+				g = g.with([]string{k})
+			} else {
+				g = g.without(k)
+			}
+		}
+		return g
+	case *ast.DeclStmt:
+		decl, ok := s.Decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.VAR {
+			break
+		}
+		g = g.without(c.modified(decl)...)
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ValueSpec)
+			for i, name := range spec.Names {
+				if k, ok := c.key(name); ok && len(spec.Names) == len(spec.Values) && c.isSomeCall(spec.Values[i]) {
+					g = g.with([]string{k})
+				}
+			}
+		}
+		return g
+	}
+
+	g = g.without(c.modified(s)...)
+	switch s := s.(type) {
+	case *ast.IfStmt:
+		if terminates(c.pass, s.Body) {
+			g = g.with(c.noneKeys(s.Cond))
+		}
+		if els, ok := s.Else.(*ast.BlockStmt); ok && terminates(c.pass, els) {
+			g = g.with(c.someKeys(s.Cond))
+		}
+	case *ast.ExprStmt:
+		call, ok := ast.Unparen(s.X).(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		recv, name, ok := c.optionMethod(call)
+		if !ok || !someMethods[name] {
+			break
+		}
+		if k, ok := c.key(recv); ok {
+			g = g.with([]string{k})
+		}
+	}
+	return g
+}
+
+// someMethods are the methods of Option that leave it `Some`.
+var someMethods = map[string]bool{
+	"Insert":             true,
+	"GetOrInsert":        true,
+	"GetOrInsertDefault": true,
+	"GetOrInsertWith":    true,
+	"Replace":            true,
+}
+
+// modified returns the keys of the values that n may modify:
+// those it assigns to, takes the address of, or calls a pointer
+// method of Option on other than someMethods. Function literals
+// within n count, since they may be called from it.
+func (c *checker) modified(n ast.Node) []string {
+	var keys []string
+	add := func(e ast.Expr) {
+		if k, ok := c.key(e); ok {
+			keys = append(keys, k)
+		}
+	}
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				add(lhs)
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				if n.Key != nil {
+					add(n.Key)
+				}
+				if n.Value != nil {
+					add(n.Value)
+				}
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				add(n.X)
+			}
+		case *ast.SelectorExpr:
+			// Calls and method values both select the method.
+			sel, ok := c.pass.TypesInfo.Selections[n]
+			if !ok || sel.Kind() != types.MethodVal || someMethods[sel.Obj().Name()] {
+				break
+			}
+			recv := sel.Obj().(*types.Func).Signature().Recv().Type()
+			if _, ok := recv.(*types.Pointer); ok && isOption(recv) {
+				add(n.X)
+			}
+		}
+		return true
+	})
+	return keys
+}
+
+func (c *checker) stmt(s ast.Stmt, g guards) {
+	switch s := s.(type) {
+	case *ast.BlockStmt:
+		c.stmts(s.List, g)
+	case *ast.LabeledStmt:
+		c.stmt(s.Stmt, g)
+	case *ast.IfStmt:
+		if s.Init != nil {
+			c.stmt(s.Init, g)
+			g = c.after(s.Init, g)
+		}
+		c.node(s.Cond, g)
+		c.stmts(s.Body.List, g.with(c.someKeys(s.Cond)))
+		if s.Else != nil {
+			c.stmt(s.Else, g.with(c.noneKeys(s.Cond)))
+		}
+	case *ast.ForStmt:
+		if s.Init != nil {
+			c.stmt(s.Init, g)
+			g = c.after(s.Init, g)
+		}
+		// A guard only holds on every iteration if nothing in the
+		// loop modifies the value.
+		g = g.without(c.modified(s.Body)...)
+		if s.Post != nil {
+			g = g.without(c.modified(s.Post)...)
+		}
+		if s.Cond != nil {
+			c.node(s.Cond, g)
+			g = g.with(c.someKeys(s.Cond))
+		}
+		if s.Post != nil {
+			c.stmt(s.Post, g)
+		}
+		c.stmts(s.Body.List, g)
+	case *ast.RangeStmt:
+		c.node(s.X, g)
+		c.stmts(s.Body.List, g.without(c.modified(s)...))
+	case *ast.SwitchStmt:
+		if s.Init != nil {
+			c.stmt(s.Init, g)
+			g = c.after(s.Init, g)
+		}
+		if s.Tag != nil {
+			c.node(s.Tag, g)
+		}
+		for _, clause := range s.Body.List {
+			clause := clause.(*ast.CaseClause)
+			cg := g
+			for _, e := range clause.List {
+				c.node(e, g)
+			}
+			if s.Tag == nil && len(clause.List) == 1 {
+				cg = g.with(c.someKeys(clause.List[0]))
+			}
+			c.stmts(clause.Body, cg)
+		}
+	case *ast.TypeSwitchStmt:
+		if s.Init != nil {
+			c.stmt(s.Init, g)
+			g = c.after(s.Init, g)
+		}
+		c.stmt(s.Assign, g)
+		for _, clause := range s.Body.List {
+			c.stmts(clause.(*ast.CaseClause).Body, g)
+		}
+	case *ast.SelectStmt:
+		for _, clause := range s.Body.List {
+			clause := clause.(*ast.CommClause)
+			if clause.Comm != nil {
+				c.stmt(clause.Comm, g)
+			}
+			c.stmts(clause.Body, g)
+		}
+	default:
+		c.node(s, g)
+	}
+}
+
+// node checks the calls within n, which contains no statements
+// other than those in function literals.
+func (c *checker) node(n ast.Node, g guards) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// The function may run at any later point, so only
+			// values that are never modified stay guarded.
+			c.stmts(n.Body.List, g.without(c.funcModified...))
+			return false
+		case *ast.BinaryExpr:
+			switch n.Op {
+			case token.LAND:
+				c.node(n.X, g)
+				c.node(n.Y, g.with(c.someKeys(n.X)))
+				return false
+			case token.LOR:
+				c.node(n.X, g)
+				c.node(n.Y, g.with(c.noneKeys(n.X)))
+				return false
+			}
+		case *ast.CallExpr:
+			c.checkCall(n, g)
+		}
+		return true
+	})
+}
+
+func (c *checker) checkCall(call *ast.CallExpr, g guards) {
+	recv, name, ok := c.optionMethod(call)
+	if !ok || name != "Unwrap" && name != "Expect" {
+		return
+	}
+	if k, ok := c.key(recv); ok && g[k] {
+		return
+	}
+	// No fix is suggested: which fallback is right depends on the
+	// caller, and a zero value would silently hide the bug.
+	c.pass.Reportf(call.Pos(), "call to %s.%s is not guarded by an IsSome or IsNone check; consider UnwrapOr or option.MapOr",
+		types.ExprString(recv), name)
+}
+
+// someKeys returns the keys of the values that are `Some`
+// whenever cond is true.
+func (c *checker) someKeys(cond ast.Expr) []string {
+	switch cond := ast.Unparen(cond).(type) {
+	case *ast.UnaryExpr:
+		if cond.Op == token.NOT {
+			return c.noneKeys(cond.X)
+		}
+	case *ast.BinaryExpr:
+		if cond.Op == token.LAND {
+			return append(c.someKeys(cond.X), c.someKeys(cond.Y)...)
+		}
+	case *ast.CallExpr:
+		if recv, name, ok := c.optionMethod(cond); ok && (name == "IsSome" || name == "IsSomeAnd") {
+			if k, ok := c.key(recv); ok {
+				return []string{k}
+			}
+		}
+	}
+	return nil
+}
+
+// noneKeys returns the keys of the values that are `Some`
+// whenever cond is false.
+func (c *checker) noneKeys(cond ast.Expr) []string {
+	switch cond := ast.Unparen(cond).(type) {
+	case *ast.UnaryExpr:
+		if cond.Op == token.NOT {
+			return c.someKeys(cond.X)
+		}
+	case *ast.BinaryExpr:
+		if cond.Op == token.LOR {
+			return append(c.noneKeys(cond.X), c.noneKeys(cond.Y)...)
+		}
+	case *ast.CallExpr:
+		if recv, name, ok := c.optionMethod(cond); ok && name == "IsNone" {
+			if k, ok := c.key(recv); ok {
+				return []string{k}
+			}
+		}
+	}
+	return nil
+}
+
+// key identifies the value denoted by e, which must be a variable
+// or a chain of field selections on a variable.
+func (c *checker) key(e ast.Expr) (string, bool) {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		obj := c.pass.TypesInfo.ObjectOf(e)
+		if _, ok := obj.(*types.Var); !ok {
+			return "", false
+		}
+		return fmt.Sprintf("%p", obj), true
+	case *ast.SelectorExpr:
+		sel, ok := c.pass.TypesInfo.Selections[e]
+		if !ok || sel.Kind() != types.FieldVal {
+			return "", false
+		}
+		base, ok := c.key(e.X)
+		if !ok {
+			return "", false
+		}
+		return base + "." + e.Sel.Name, true
+	case *ast.StarExpr:
+		return c.key(e.X)
+	}
+	return "", false
+}
+
+// optionMethod reports whether call is a call to a method of
+// option.Option, and returns its receiver and name.
+func (c *checker) optionMethod(call *ast.CallExpr) (ast.Expr, string, bool) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return nil, "", false
+	}
+	recv := fn.Signature().Recv()
+	if recv == nil || !isOption(recv.Type()) {
+		return nil, "", false
+	}
+	return sel.X, fn.Name(), true
+}
+
+// isSomeCall reports whether e is a call to option.Some.
+func (c *checker) isSomeCall(e ast.Expr) bool {
+	call, ok := ast.Unparen(e).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == optionPath && fn.Name() == "Some"
+}
+
+// isOption reports whether t is option.Option or a pointer to it.
+func isOption(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == optionPath && obj.Name() == "Option"
+}
+
+// terminates reports whether the last statement of block
+// always exits it, by returning, branching or panicking.
+func terminates(pass *analysis.Pass, block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+	switch s := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := ast.Unparen(s.X).(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		switch fn := typeutil.Callee(pass.TypesInfo, call).(type) {
+		case *types.Builtin:
+			return fn.Name() == "panic"
+		case *types.Func:
+			return noReturn[fn.FullName()]
+		}
+	}
+	return false
+}
+
+// noReturn is the set of functions that never return normally.
+var noReturn = map[string]bool{
+	"os.Exit":                   true,
+	"log.Fatal":                 true,
+	"log.Fatalf":                true,
+	"log.Fatalln":               true,
+	"log.Panic":                 true,
+	"log.Panicf":                true,
+	"log.Panicln":               true,
+	"(*log.Logger).Fatal":       true,
+	"(*log.Logger).Fatalf":      true,
+	"(*log.Logger).Fatalln":     true,
+	"(*log.Logger).Panic":       true,
+	"(*log.Logger).Panicf":      true,
+	"(*log.Logger).Panicln":     true,
+	"(*testing.common).FailNow": true,
+	"(*testing.common).Fatal":   true,
+	"(*testing.common).Fatalf":  true,
+	"(*testing.common).SkipNow": true,
+	"(*testing.common).Skip":    true,
+	"(*testing.common).Skipf":   true,
+	"runtime.Goexit":            true,
+}
@@ -0,0 +1,12 @@
+package unwrapcheck_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-option/tools/analysis/unwrapcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), unwrapcheck.Analyzer, "a")
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// optionPath is the import path of the option package.
+const optionPath = "github.com/JustinKnueppel/go-option"
+
+// Generator generates Option accessors for the struct types of
+// a package.
+type Generator struct {
+	// Prefix is the prefix of the getter names.
+	Prefix string
+	// Command is the command line recorded in the generated header.
+	Command string
+
+	fset    *token.FileSet
+	files   []*ast.File
+	pkgName string
+	methods map[string]map[string]bool
+	imports map[string]string
+	buf     bytes.Buffer
+}
+
+// Load parses the non-test Go files of the package in dir that match
+// the build constraints, skipping the file named skip, which is usually
+// the previous output of the generator.
+func (g *Generator) Load(dir, skip string) error {
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return err
+	}
+	g.fset = token.NewFileSet()
+	g.methods = map[string]map[string]bool{}
+	g.pkgName = pkg.Name
+	for _, name := range slices.Concat(pkg.GoFiles, pkg.CgoFiles) {
+		if name == skip {
+			continue
+		}
+		file, err := parser.ParseFile(g.fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		g.files = append(g.files, file)
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+				recv := recvTypeName(fn.Recv.List[0].Type)
+				if g.methods[recv] == nil {
+					g.methods[recv] = map[string]bool{}
+				}
+				g.methods[recv][fn.Name.Name] = true
+			}
+		}
+	}
+	if len(g.files) == 0 {
+		return fmt.Errorf("no Go files in %s", dir)
+	}
+	return nil
+}
+
+// Generate returns the formatted source of the accessors for the
+// named types. It fails if none of their fields get accessors.
+func (g *Generator) Generate(typeNames []string) ([]byte, error) {
+	if g.Prefix == "" {
+		return nil, fmt.Errorf("empty getter prefix; the getters would have the names of the fields")
+	}
+	g.imports = map[string]string{optionPath: "option"}
+	g.buf.Reset()
+	for _, name := range typeNames {
+		if err := g.generateType(name); err != nil {
+			return nil, err
+		}
+	}
+	if g.buf.Len() == 0 {
+		return nil, fmt.Errorf("no accessors to generate for %s; fields need a pointer or map type, or an option:\"zero\" tag", strings.Join(typeNames, ", "))
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by %q; DO NOT EDIT.\n\n", g.Command)
+	fmt.Fprintf(&out, "package %s\n\n", g.pkgName)
+	out.WriteString("import (\n")
+	// Standard library packages come first, in their own group.
+	var std, other []string
+	for _, p := range slices.Sorted(maps.Keys(g.imports)) {
+		if first, _, _ := strings.Cut(p, "/"); strings.Contains(first, ".") {
+			other = append(other, p)
+		} else {
+			std = append(std, p)
+		}
+	}
+	for i, group := range [][]string{std, other} {
+		if i > 0 && len(std) > 0 {
+			out.WriteString("\n")
+		}
+		for _, p := range group {
+			if name := g.imports[p]; name != importName(p) {
+				fmt.Fprintf(&out, "\t%s %q\n", name, p)
+			} else {
+				fmt.Fprintf(&out, "\t%q\n", p)
+			}
+		}
+	}
+	out.WriteString(")\n")
+	out.Write(g.buf.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, out.Bytes())
+	}
+	return src, nil
+}
+
+// accessor is a field that gets a getter and a setter.
+type accessor struct {
+	field string
+	kind  string
+	typ   ast.Expr
+}
+
+func (g *Generator) generateType(name string) error {
+	file, spec := g.lookupType(name)
+	if spec == nil {
+		return fmt.Errorf("type %s not found in package %s", name, g.pkgName)
+	}
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return fmt.Errorf("type %s is not a struct", name)
+	}
+
+	var accessors []accessor
+	for _, field := range st.Fields.List {
+		tag := ""
+		if field.Tag != nil {
+			value, _ := strconv.Unquote(field.Tag.Value)
+			tag = reflect.StructTag(value).Get("option")
+		}
+		kind := ""
+		switch field.Type.(type) {
+		case *ast.StarExpr:
+			kind = "pointer"
+		case *ast.MapType:
+			kind = "map"
+		}
+		switch tag {
+		case "":
+		case "-":
+			continue
+		case "zero":
+			kind = "zero"
+		default:
+			return fmt.Errorf("%s: unknown option tag %q", g.fset.Position(field.Tag.Pos()), tag)
+		}
+		if kind == "" {
+			continue
+		}
+		for _, id := range field.Names {
+			if id.IsExported() {
+				accessors = append(accessors, accessor{field: id.Name, kind: kind, typ: field.Type})
+			}
+		}
+	}
+
+	recvType := name
+	if spec.TypeParams != nil {
+		var params []string
+		for _, field := range spec.TypeParams.List {
+			for _, id := range field.Names {
+				params = append(params, id.Name)
+			}
+		}
+		recvType += "[" + strings.Join(params, ", ") + "]"
+	}
+	recv := receiverName(name)
+
+	for _, a := range accessors {
+		getter, setter := g.Prefix+a.field, "Set"+a.field
+		for _, method := range []string{getter, setter} {
+			if g.methods[name][method] {
+				return fmt.Errorf("type %s already has a method %s; use -prefix to choose other getter names", name, method)
+			}
+		}
+		if err := g.addImports(file, a.typ); err != nil {
+			return err
+		}
+		f := recv + "." + a.field
+		switch a.kind {
+		case "pointer":
+			elem := types.ExprString(a.typ.(*ast.StarExpr).X)
+			g.printf("\n// %s returns the value %s points to, or None if %s or %s is nil.\n", getter, f, recv, f)
+			g.printf("func (%s *%s) %s() option.Option[%s] {\n", recv, recvType, getter, elem)
+			g.printf("\tif %s == nil {\n\t\treturn option.None[%s]()\n\t}\n", recv, elem)
+			g.printf("\treturn option.FromPtr(%s)\n}\n", f)
+			g.printf("\n// %s sets %s to a pointer to the value of opt, or to nil if opt is None.\n", setter, f)
+			g.printf("func (%s *%s) %s(opt option.Option[%s]) {\n", recv, recvType, setter, elem)
+			g.printf("\t%s = opt.ToPtr()\n}\n", f)
+		case "map":
+			m := a.typ.(*ast.MapType)
+			key, value := types.ExprString(m.Key), types.ExprString(m.Value)
+			g.printf("\n// %s returns the value of %s for key, or None if key is not present.\n", getter, f)
+			g.printf("func (%s *%s) %s(key %s) option.Option[%s] {\n", recv, recvType, getter, key, value)
+			g.printf("\tif %s == nil {\n\t\treturn option.None[%s]()\n\t}\n", recv, value)
+			g.printf("\tv, ok := %s[key]\n\treturn option.FromOk(v, ok)\n}\n", f)
+			g.printf("\n// %s sets %s for key to the value of opt, or deletes key if opt is None.\n", setter, f)
+			g.printf("func (%s *%s) %s(key %s, opt option.Option[%s]) {\n", recv, recvType, setter, key, value)
+			g.printf("\tif opt.IsNone() {\n\t\tdelete(%s, key)\n\t\treturn\n\t}\n", f)
+			g.printf("\tif %s == nil {\n\t\t%s = %s{}\n\t}\n", f, f, types.ExprString(m))
+			g.printf("\t%s[key] = opt.Unwrap()\n}\n", f)
+		case "zero":
+			typ := types.ExprString(a.typ)
+			g.printf("\n// %s returns the value of %s, or None if it is the zero value.\n", getter, f)
+			g.printf("func (%s *%s) %s() option.Option[%s] {\n", recv, recvType, getter, typ)
+			if nilable(a.typ) {
+				g.printf("\tif %s == nil || %s == nil {\n", recv, f)
+			} else {
+				g.printf("\tvar zero %s\n\tif %s == nil || %s == zero {\n", typ, recv, f)
+			}
+			g.printf("\t\treturn option.None[%s]()\n\t}\n", typ)
+			g.printf("\treturn option.Some(%s)\n}\n", f)
+			g.printf("\n// %s sets %s to the value of opt, or to the zero value if opt is None.\n", setter, f)
+			g.printf("func (%s *%s) %s(opt option.Option[%s]) {\n", recv, recvType, setter, typ)
+			g.printf("\t%s = opt.UnwrapOrDefault()\n}\n", f)
+		}
+	}
+	return nil
+}
+
+// lookupType returns the declaration of the named type and the file
+// that declares it.
+func (g *Generator) lookupType(name string) (*ast.File, *ast.TypeSpec) {
+	for _, file := range g.files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				if spec := spec.(*ast.TypeSpec); spec.Name.Name == name {
+					return file, spec
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// addImports records the imports of file that typ refers to.
+func (g *Generator) addImports(file *ast.File, typ ast.Expr) error {
+	var err error
+	ast.Inspect(typ, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		for _, spec := range file.Imports {
+			p, _ := strconv.Unquote(spec.Path.Value)
+			name := importName(p)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			if name == pkg.Name {
+				g.imports[p] = name
+				return false
+			}
+		}
+		err = fmt.Errorf("%s: cannot find the import of %s", g.fset.Position(sel.Pos()), pkg.Name)
+		return false
+	})
+	return err
+}
+
+func (g *Generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// receiverName returns the receiver name for methods of the named
+// type, avoiding the local variable v of the map getters.
+func receiverName(typeName string) string {
+	r, _ := utf8.DecodeRuneInString(typeName)
+	if name := string(unicode.ToLower(r)); name != "v" {
+		return name
+	}
+	return "x"
+}
+
+// recvTypeName returns the name of the type of a method receiver.
+func recvTypeName(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
+
+// nilable reports whether the zero value of typ is nil.
+func nilable(typ ast.Expr) bool {
+	switch t := typ.(type) {
+	case *ast.ArrayType:
+		return t.Len == nil
+	case *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.InterfaceType, *ast.StarExpr:
+		return true
+	}
+	return false
+}
+
+// importName returns the name a package is imported as by default,
+// following the convention that github.com/x/go-y/v2 is package y.
+func importName(p string) string {
+	name := path.Base(p)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" && path.Dir(p) != "." {
+		name = path.Base(path.Dir(p))
+	}
+	return strings.TrimPrefix(name, "go-")
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	g := &Generator{Prefix: "Get", Command: "optiongen -type=User,Box"}
+	if err := g.Load(filepath.Join("testdata", "user"), "user_option.go"); err != nil {
+		t.Fatal(err)
+	}
+	got, err := g.Generate([]string{"User", "Box"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join("testdata", "user", "user_option.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generated code differs from testdata/user/user_option.go; run go generate ./cmd/optiongen/testdata/user\n%s", got)
+	}
+}
+func TestGeneratedCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go vet in short mode")
+	}
+	// The tools module does not depend on the option package, so
+	// vet the generated code in a module that uses the one in this
+	// repository.
+	root, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	for _, name := range []string{"user.go", "user_option.go"} {
+		src, err := os.ReadFile(filepath.Join("testdata", "user", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mod := "module user\n\ngo 1.24\n\nrequire " + optionPath + " v0.0.0\n\nreplace " + optionPath + " => " + root + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "vet", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Errorf("go vet: %v\n%s", err, out)
+	}
+}
+func TestGenerateErrors(t *testing.T) {
+	tests := map[string]struct {
+		typ    string
+		prefix string
+		err    string
+	}{
+		"missing_type": {
+			typ:    "Missing",
+			prefix: "Get",
+			err:    "type Missing not found in package invalid",
+		},
+		"not_a_struct": {
+			typ:    "Name",
+			prefix: "Get",
+			err:    "type Name is not a struct",
+		},
+		"unknown_tag": {
+			typ:    "Tagged",
+			prefix: "Get",
+			err:    `unknown option tag "maybe"`,
+		},
+		"existing_method": {
+			typ:    "Proto",
+			prefix: "Get",
+			err:    "type Proto already has a method GetName",
+		},
+		"no_accessors": {
+			typ:    "Plain",
+			prefix: "Get",
+			err:    "no accessors to generate for Plain",
+		},
+		"prefix_avoids_existing_method": {
+			typ:    "Proto",
+			prefix: "Opt",
+		},
+		"empty_prefix": {
+			typ:    "Proto",
+			prefix: "",
+			err:    "empty getter prefix",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			g := &Generator{Prefix: tc.prefix}
+			if err := g.Load(filepath.Join("testdata", "invalid"), ""); err != nil {
+				t.Fatal(err)
+			}
+			_, err := g.Generate([]string{tc.typ})
+			if tc.err == "" && err != nil || tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Errorf("got error %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// The optiongen command generates accessors that return option.Option
+// for the fields of struct types. It is meant to be run by go generate:
+//
+//	//go:generate optiongen -type=User,Account
+//
+// For each exported field of the named types, it generates a getter
+// and a setter:
+//
+//   - a field X *T gets GetX() option.Option[T], which is None when X
+//     is nil, and SetX(option.Option[T]);
+//   - a field X map[K]V gets GetX(key K) option.Option[V], which is None
+//     when key is not present, and SetX(key K, option.Option[V]), which
+//     deletes key when given None;
+//   - a field X T tagged `option:"zero"` gets GetX() option.Option[T],
+//     which is None when X is the zero value, and SetX(option.Option[T]).
+//     T must be comparable or have nil as its zero value.
+//
+// Fields tagged `option:"-"` are skipped. Getters return None when
+// called on a nil receiver.
+//
+// The accessors for the types are written to the file named by -output,
+// or to <type>_option.go for the first type. A relative -output is taken
+// to be relative to the package directory. When the types already have
+// methods named GetX, such as the getters of generated protobuf code,
+// use -prefix to choose other getter names:
+//
+//	//go:generate optiongen -type=User -prefix=Opt
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	typeNames = flag.String("type", "", "comma-separated list of struct type names; must be set")
+	output    = flag.String("output", "", "output file name; default <type>_option.go")
+	prefix    = flag.String("prefix", "Get", "prefix of the getter names")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of optiongen:\n")
+	fmt.Fprintf(os.Stderr, "\toptiongen [flags] -type T [directory]\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("optiongen: ")
+	flag.Usage = usage
+	flag.Parse()
+	if *typeNames == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *prefix == "" {
+		log.Fatal("-prefix must not be empty")
+	}
+	types := strings.Split(*typeNames, ",")
+
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	name := *output
+	if name == "" {
+		name = strings.ToLower(types[0]) + "_option.go"
+	}
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(dir, name)
+	}
+	// Skip the previous output if it is in the package.
+	skip := ""
+	if filepath.Dir(name) == filepath.Clean(dir) {
+		skip = filepath.Base(name)
+	}
+
+	g := &Generator{
+		Prefix:  *prefix,
+		Command: "optiongen " + strings.Join(os.Args[1:], " "),
+	}
+	if err := g.Load(dir, skip); err != nil {
+		log.Fatal(err)
+	}
+	src, err := g.Generate(types)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(name, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build ignore
+
+// This file is excluded by its build constraint, so it does not
+// conflict with package invalid.
+package main
+
+func main() {}
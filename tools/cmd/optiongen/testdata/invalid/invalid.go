@@ -0,0 +1,20 @@
+package invalid
+
+type Name string
+
+type Tagged struct {
+	Age int `option:"maybe"`
+}
+
+type Plain struct{ A int }
+
+type Proto struct {
+	Name *string
+}
+
+func (p *Proto) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
@@ -0,0 +1,27 @@
+// Package user is an example input for optiongen.
+package user
+
+import (
+	"time"
+)
+
+//go:generate go run github.com/JustinKnueppel/go-option/tools/cmd/optiongen -type=User,Box
+
+type User struct {
+	ID       int
+	Name     *string
+	Birthday *time.Time
+	Labels   map[string]string
+	Age      int      `option:"zero"`
+	Tags     []string `option:"zero"`
+	Nickname *string  `option:"-"`
+	manager  *User
+}
+
+func (u *User) Manager() *User {
+	return u.manager
+}
+
+type Box[T any] struct {
+	Value *T
+}
@@ -0,0 +1,96 @@
+// Code generated by "optiongen -type=User,Box"; DO NOT EDIT.
+
+package user
+
+import (
+	"time"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+// GetName returns the value u.Name points to, or None if u or u.Name is nil.
+func (u *User) GetName() option.Option[string] {
+	if u == nil {
+		return option.None[string]()
+	}
+	return option.FromPtr(u.Name)
+}
+
+// SetName sets u.Name to a pointer to the value of opt, or to nil if opt is None.
+func (u *User) SetName(opt option.Option[string]) {
+	u.Name = opt.ToPtr()
+}
+
+// GetBirthday returns the value u.Birthday points to, or None if u or u.Birthday is nil.
+func (u *User) GetBirthday() option.Option[time.Time] {
+	if u == nil {
+		return option.None[time.Time]()
+	}
+	return option.FromPtr(u.Birthday)
+}
+
+// SetBirthday sets u.Birthday to a pointer to the value of opt, or to nil if opt is None.
+func (u *User) SetBirthday(opt option.Option[time.Time]) {
+	u.Birthday = opt.ToPtr()
+}
+
+// GetLabels returns the value of u.Labels for key, or None if key is not present.
+func (u *User) GetLabels(key string) option.Option[string] {
+	if u == nil {
+		return option.None[string]()
+	}
+	v, ok := u.Labels[key]
+	return option.FromOk(v, ok)
+}
+
+// SetLabels sets u.Labels for key to the value of opt, or deletes key if opt is None.
+func (u *User) SetLabels(key string, opt option.Option[string]) {
+	if opt.IsNone() {
+		delete(u.Labels, key)
+		return
+	}
+	if u.Labels == nil {
+		u.Labels = map[string]string{}
+	}
+	u.Labels[key] = opt.Unwrap()
+}
+
+// GetAge returns the value of u.Age, or None if it is the zero value.
+func (u *User) GetAge() option.Option[int] {
+	var zero int
+	if u == nil || u.Age == zero {
+		return option.None[int]()
+	}
+	return option.Some(u.Age)
+}
+
+// SetAge sets u.Age to the value of opt, or to the zero value if opt is None.
+func (u *User) SetAge(opt option.Option[int]) {
+	u.Age = opt.UnwrapOrDefault()
+}
+
+// GetTags returns the value of u.Tags, or None if it is the zero value.
+func (u *User) GetTags() option.Option[[]string] {
+	if u == nil || u.Tags == nil {
+		return option.None[[]string]()
+	}
+	return option.Some(u.Tags)
+}
+
+// SetTags sets u.Tags to the value of opt, or to the zero value if opt is None.
+func (u *User) SetTags(opt option.Option[[]string]) {
+	u.Tags = opt.UnwrapOrDefault()
+}
+
+// GetValue returns the value b.Value points to, or None if b or b.Value is nil.
+func (b *Box[T]) GetValue() option.Option[T] {
+	if b == nil {
+		return option.None[T]()
+	}
+	return option.FromPtr(b.Value)
+}
+
+// SetValue sets b.Value to a pointer to the value of opt, or to nil if opt is None.
+func (b *Box[T]) SetValue(opt option.Option[T]) {
+	b.Value = opt.ToPtr()
+}
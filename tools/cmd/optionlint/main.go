@@ -0,0 +1,19 @@
+// The optionlint command reports common misuses of option.Option.
+//
+// It can be run directly on packages:
+//
+//	optionlint ./...
+//
+// or as a vet tool:
+//
+//	go vet -vettool=$(which optionlint) ./...
+package main
+
+import (
+	"github.com/JustinKnueppel/go-option/tools/analysis/optionlint"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(optionlint.Analyzer)
+}
@@ -0,0 +1,22 @@
+// The optionmigrate command rewrites functions that return a possibly
+// nil *T to return option.Option[T], and updates their callers.
+//
+// It works on one package at a time. Preview the changes with -diff
+// before applying them:
+//
+//	optionmigrate -fix -diff ./store
+//	optionmigrate -fix ./store
+//
+// Use -funcs to migrate only some functions:
+//
+//	optionmigrate -fix -funcs=FindUser,Store.Get ./store
+package main
+
+import (
+	"github.com/JustinKnueppel/go-option/tools/analysis/optionmigrate"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(optionmigrate.Analyzer)
+}
@@ -0,0 +1,20 @@
+// The unwrapcheck command reports calls to Option.Unwrap and
+// Option.Expect that are not guarded by an IsSome or IsNone check.
+//
+// It can be run directly on packages:
+//
+//	unwrapcheck ./...
+//
+// or as a vet tool:
+//
+//	go vet -vettool=$(which unwrapcheck) ./...
+package main
+
+import (
+	"github.com/JustinKnueppel/go-option/tools/analysis/unwrapcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(unwrapcheck.Analyzer)
+}
@@ -0,0 +1,40 @@
+package option
+
+// Try calls f and returns `Some` of its result if the returned
+// error is nil, otherwise `None`.
+func Try[T any](f func() (T, error)) Option[T] {
+	return FromErr(f())
+}
+
+// TryMap maps an Option[T] to an Option[U] by applying a fallible
+// function to the contained value if it exists. It returns `None`
+// if the option is `None` or f returns an error.
+func TryMap[T any, U any](o Option[T], f func(T) (U, error)) Option[U] {
+	if o.IsNone() {
+		return noneFrom[U](o)
+	}
+	return FromErr(f(o.data))
+}
+
+// Catch calls f and returns `Some` of its result, or `None`
+// if f panics.
+func Catch[T any](f func() T) Option[T] {
+	return CatchWith(f, func(any) {})
+}
+
+// CatchWith calls f and returns `Some` of its result. If f
+// panics, the recovered value is passed to onPanic and `None`
+// is returned. Short circuits from Bind are not caught, so
+// CatchWith can be used inside a Do block.
+func CatchWith[T any](f func() T, onPanic func(recovered any)) (result Option[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(shortCircuit); ok {
+				panic(r)
+			}
+			onPanic(r)
+			result = None[T]()
+		}
+	}()
+	return Some(f())
+}
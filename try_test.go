@@ -0,0 +1,129 @@
+package option_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestTry(t *testing.T) {
+	tests := map[string]struct {
+		fn     func() (int, error)
+		result option.Option[int]
+	}{
+		"nil_error": {
+			fn:     func() (int, error) { return 1, nil },
+			result: option.Some(1),
+		},
+		"error": {
+			fn:     func() (int, error) { return 1, errors.New("failed") },
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.Try(tc.fn), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestTryMap(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[string]
+		result option.Option[int]
+	}{
+		"some_valid": {
+			value:  option.Some("1"),
+			result: option.Some(1),
+		},
+		"some_invalid": {
+			value:  option.Some("one"),
+			result: option.None[int](),
+		},
+		"no_value": {
+			value:  option.None[string](),
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.TryMap(tc.value, strconv.Atoi), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestCatch(t *testing.T) {
+	tests := map[string]struct {
+		fn     func() int
+		result option.Option[int]
+	}{
+		"no_panic": {
+			fn:     func() int { return 1 },
+			result: option.Some(1),
+		},
+		"panic": {
+			fn:     func() int { panic("failed") },
+			result: option.None[int](),
+		},
+		"nil_panic": {
+			fn:     func() int { panic(nil) },
+			result: option.None[int](),
+		},
+		"unwrap_none": {
+			fn:     func() int { return option.None[int]().Unwrap() },
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.Catch(tc.fn), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestCatchWith(t *testing.T) {
+	tests := map[string]struct {
+		fn        func() int
+		result    option.Option[int]
+		recovered any
+	}{
+		"no_panic": {
+			fn:        func() int { return 1 },
+			result:    option.Some(1),
+			recovered: nil,
+		},
+		"panic": {
+			fn:        func() int { panic("failed") },
+			result:    option.None[int](),
+			recovered: "failed",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var recovered any
+			result := option.CatchWith(tc.fn, func(r any) { recovered = r })
+			if !equal(result, tc.result) || recovered != tc.recovered {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestCatchInsideDo(t *testing.T) {
+	result := option.Do(func(b *option.Scope) option.Option[int] {
+		return option.Catch(func() int {
+			return option.Bind(b, option.None[int]())
+		})
+	})
+	if !equalFunc(result, option.None[option.Option[int]](), equal[int]) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,50 @@
+package option
+
+// Variant is a sealed view of an Option, implemented only by
+// SomeOf[T] and NoneOf[T]. It allows an Option to be inspected
+// with a type switch:
+//
+//	switch v := opt.Variant().(type) {
+//	case option.SomeOf[int]:
+//		fmt.Println(v.Value)
+//	case option.NoneOf[int]:
+//		fmt.Println("No value exists")
+//	}
+//
+//sumtype:decl
+type Variant[T any] interface {
+	// Option converts the variant back into an Option.
+	Option() Option[T]
+	isVariant()
+}
+
+// SomeOf is the Variant of a `Some` option.
+type SomeOf[T any] struct {
+	Value T
+}
+
+// Option returns `Some(v.Value)`.
+func (v SomeOf[T]) Option() Option[T] {
+	return Some(v.Value)
+}
+
+func (SomeOf[T]) isVariant() {}
+
+// NoneOf is the Variant of a `None` option.
+type NoneOf[T any] struct{}
+
+// Option returns `None`.
+func (NoneOf[T]) Option() Option[T] {
+	return None[T]()
+}
+
+func (NoneOf[T]) isVariant() {}
+
+// Variant returns the option as a SomeOf[T] holding the
+// contained value if it is `Some`, otherwise as a NoneOf[T].
+func (o Option[T]) Variant() Variant[T] {
+	if o.IsNone() {
+		return NoneOf[T]{}
+	}
+	return SomeOf[T]{Value: o.data}
+}
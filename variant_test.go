@@ -0,0 +1,65 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestVariant(t *testing.T) {
+	tests := map[string]struct {
+		value   option.Option[int]
+		variant option.Variant[int]
+	}{
+		"some_value": {
+			value:   option.Some(1),
+			variant: option.SomeOf[int]{Value: 1},
+		},
+		"no_value": {
+			value:   option.None[int](),
+			variant: option.NoneOf[int]{},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			variant := tc.value.Variant()
+			if variant != tc.variant {
+				t.Fail()
+			}
+			if !equal(variant.Option(), tc.value) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestVariantTypeSwitch(t *testing.T) {
+	tests := map[string]struct {
+		value  option.Option[int]
+		result int
+	}{
+		"some_value": {
+			value:  option.Some(1),
+			result: 2,
+		},
+		"no_value": {
+			value:  option.None[int](),
+			result: -1,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var result int
+			switch v := tc.value.Variant().(type) {
+			case option.SomeOf[int]:
+				result = v.Value * 2
+			case option.NoneOf[int]:
+				result = -1
+			}
+			if result != tc.result {
+				t.Fail()
+			}
+		})
+	}
+}
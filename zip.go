@@ -0,0 +1,165 @@
+package option
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip returns `Some(Pair{a, b})` if both options are `Some`,
+// otherwise returns `None`.
+func Zip[A any, B any](oA Option[A], oB Option[B]) Option[Pair[A, B]] {
+	if oA.IsNone() {
+		return noneFrom[Pair[A, B]](oA)
+	}
+	if oB.IsNone() {
+		return noneFrom[Pair[A, B]](oB)
+	}
+	return Some(Pair[A, B]{First: oA.data, Second: oB.data})
+}
+
+// ZipWith returns `Some(f(a, b))` if both options are `Some`,
+// otherwise returns `None`. It is the same as Map2.
+func ZipWith[A any, B any, R any](oA Option[A], oB Option[B], f func(A, B) R) Option[R] {
+	return Map2(oA, oB, f)
+}
+
+// Unzip converts an option of a Pair into a Pair of options.
+// `Some(Pair{a, b})` becomes `(Some(a), Some(b))` and `None`
+// becomes `(None, None)`.
+func Unzip[A any, B any](o Option[Pair[A, B]]) (Option[A], Option[B]) {
+	if o.IsNone() {
+		return noneFrom[A](o), noneFrom[B](o)
+	}
+	return Some(o.data.First), Some(o.data.Second)
+}
+
+// Map2 applies f to the contained values of 2 options if they
+// are all `Some`, otherwise returns `None`.
+func Map2[A any, B any, R any](oA Option[A], oB Option[B], f func(A, B) R) Option[R] {
+	if oA.IsNone() {
+		return noneFrom[R](oA)
+	}
+	if oB.IsNone() {
+		return noneFrom[R](oB)
+	}
+	return Some(f(oA.data, oB.data))
+}
+
+// Map3 applies f to the contained values of 3 options if they
+// are all `Some`, otherwise returns `None`.
+func Map3[A any, B any, C any, R any](oA Option[A], oB Option[B], oC Option[C], f func(A, B, C) R) Option[R] {
+	if oA.IsNone() {
+		return noneFrom[R](oA)
+	}
+	if oB.IsNone() {
+		return noneFrom[R](oB)
+	}
+	if oC.IsNone() {
+		return noneFrom[R](oC)
+	}
+	return Some(f(oA.data, oB.data, oC.data))
+}
+
+// Map4 applies f to the contained values of 4 options if they
+// are all `Some`, otherwise returns `None`.
+func Map4[A any, B any, C any, D any, R any](oA Option[A], oB Option[B], oC Option[C], oD Option[D], f func(A, B, C, D) R) Option[R] {
+	if oA.IsNone() {
+		return noneFrom[R](oA)
+	}
+	if oB.IsNone() {
+		return noneFrom[R](oB)
+	}
+	if oC.IsNone() {
+		return noneFrom[R](oC)
+	}
+	if oD.IsNone() {
+		return noneFrom[R](oD)
+	}
+	return Some(f(oA.data, oB.data, oC.data, oD.data))
+}
+
+// Map5 applies f to the contained values of 5 options if they
+// are all `Some`, otherwise returns `None`.
+func Map5[A any, B any, C any, D any, E any, R any](oA Option[A], oB Option[B], oC Option[C], oD Option[D], oE Option[E], f func(A, B, C, D, E) R) Option[R] {
+	if oA.IsNone() {
+		return noneFrom[R](oA)
+	}
+	if oB.IsNone() {
+		return noneFrom[R](oB)
+	}
+	if oC.IsNone() {
+		return noneFrom[R](oC)
+	}
+	if oD.IsNone() {
+		return noneFrom[R](oD)
+	}
+	if oE.IsNone() {
+		return noneFrom[R](oE)
+	}
+	return Some(f(oA.data, oB.data, oC.data, oD.data, oE.data))
+}
+
+// Map6 applies f to the contained values of 6 options if they
+// are all `Some`, otherwise returns `None`.
+func Map6[A any, B any, C any, D any, E any, F any, R any](oA Option[A], oB Option[B], oC Option[C], oD Option[D], oE Option[E], oF Option[F], f func(A, B, C, D, E, F) R) Option[R] {
+	if oA.IsNone() {
+		return noneFrom[R](oA)
+	}
+	if oB.IsNone() {
+		return noneFrom[R](oB)
+	}
+	if oC.IsNone() {
+		return noneFrom[R](oC)
+	}
+	if oD.IsNone() {
+		return noneFrom[R](oD)
+	}
+	if oE.IsNone() {
+		return noneFrom[R](oE)
+	}
+	if oF.IsNone() {
+		return noneFrom[R](oF)
+	}
+	return Some(f(oA.data, oB.data, oC.data, oD.data, oE.data, oF.data))
+}
+
+// Lift2 turns a function of 2 plain values into a function
+// of 2 options, which calls f only if every input is `Some`.
+func Lift2[A any, B any, R any](f func(A, B) R) func(oA Option[A], oB Option[B]) Option[R] {
+	return func(oA Option[A], oB Option[B]) Option[R] {
+		return Map2(oA, oB, f)
+	}
+}
+
+// Lift3 turns a function of 3 plain values into a function
+// of 3 options, which calls f only if every input is `Some`.
+func Lift3[A any, B any, C any, R any](f func(A, B, C) R) func(oA Option[A], oB Option[B], oC Option[C]) Option[R] {
+	return func(oA Option[A], oB Option[B], oC Option[C]) Option[R] {
+		return Map3(oA, oB, oC, f)
+	}
+}
+
+// Lift4 turns a function of 4 plain values into a function
+// of 4 options, which calls f only if every input is `Some`.
+func Lift4[A any, B any, C any, D any, R any](f func(A, B, C, D) R) func(oA Option[A], oB Option[B], oC Option[C], oD Option[D]) Option[R] {
+	return func(oA Option[A], oB Option[B], oC Option[C], oD Option[D]) Option[R] {
+		return Map4(oA, oB, oC, oD, f)
+	}
+}
+
+// Lift5 turns a function of 5 plain values into a function
+// of 5 options, which calls f only if every input is `Some`.
+func Lift5[A any, B any, C any, D any, E any, R any](f func(A, B, C, D, E) R) func(oA Option[A], oB Option[B], oC Option[C], oD Option[D], oE Option[E]) Option[R] {
+	return func(oA Option[A], oB Option[B], oC Option[C], oD Option[D], oE Option[E]) Option[R] {
+		return Map5(oA, oB, oC, oD, oE, f)
+	}
+}
+
+// Lift6 turns a function of 6 plain values into a function
+// of 6 options, which calls f only if every input is `Some`.
+func Lift6[A any, B any, C any, D any, E any, F any, R any](f func(A, B, C, D, E, F) R) func(oA Option[A], oB Option[B], oC Option[C], oD Option[D], oE Option[E], oF Option[F]) Option[R] {
+	return func(oA Option[A], oB Option[B], oC Option[C], oD Option[D], oE Option[E], oF Option[F]) Option[R] {
+		return Map6(oA, oB, oC, oD, oE, oF, f)
+	}
+}
@@ -0,0 +1,155 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-option"
+)
+
+func TestZip(t *testing.T) {
+	tests := map[string]struct {
+		a      option.Option[int]
+		b      option.Option[string]
+		result option.Option[option.Pair[int, string]]
+	}{
+		"some_some": {
+			a:      option.Some(1),
+			b:      option.Some("a"),
+			result: option.Some(option.Pair[int, string]{First: 1, Second: "a"}),
+		},
+		"some_none": {
+			a:      option.Some(1),
+			b:      option.None[string](),
+			result: option.None[option.Pair[int, string]](),
+		},
+		"none_some": {
+			a:      option.None[int](),
+			b:      option.Some("a"),
+			result: option.None[option.Pair[int, string]](),
+		},
+		"none_none": {
+			a:      option.None[int](),
+			b:      option.None[string](),
+			result: option.None[option.Pair[int, string]](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(option.Zip(tc.a, tc.b), tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestZipWith(t *testing.T) {
+	tests := map[string]struct {
+		a      option.Option[int]
+		b      option.Option[int]
+		result option.Option[int]
+	}{
+		"some_some": {
+			a:      option.Some(1),
+			b:      option.Some(2),
+			result: option.Some(3),
+		},
+		"some_none": {
+			a:      option.Some(1),
+			b:      option.None[int](),
+			result: option.None[int](),
+		},
+		"none_some": {
+			a:      option.None[int](),
+			b:      option.Some(2),
+			result: option.None[int](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			sum := option.ZipWith(tc.a, tc.b, func(a, b int) int { return a + b })
+			if !equal(sum, tc.result) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestUnzip(t *testing.T) {
+	tests := map[string]struct {
+		value option.Option[option.Pair[int, string]]
+		a     option.Option[int]
+		b     option.Option[string]
+	}{
+		"some_value": {
+			value: option.Some(option.Pair[int, string]{First: 1, Second: "a"}),
+			a:     option.Some(1),
+			b:     option.Some("a"),
+		},
+		"no_value": {
+			value: option.None[option.Pair[int, string]](),
+			a:     option.None[int](),
+			b:     option.None[string](),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			a, b := option.Unzip(tc.value)
+			if !equal(a, tc.a) || !equal(b, tc.b) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestMapN(t *testing.T) {
+	one, none := option.Some(1), option.None[int]()
+	add2 := func(a, b int) int { return a + b }
+	add3 := func(a, b, c int) int { return a + b + c }
+	add4 := func(a, b, c, d int) int { return a + b + c + d }
+	add5 := func(a, b, c, d, e int) int { return a + b + c + d + e }
+	add6 := func(a, b, c, d, e, f int) int { return a + b + c + d + e + f }
+	tests := map[string]struct {
+		result   option.Option[int]
+		expected option.Option[int]
+	}{
+		"map2_some":  {result: option.Map2(one, one, add2), expected: option.Some(2)},
+		"map2_none":  {result: option.Map2(one, none, add2), expected: none},
+		"map3_some":  {result: option.Map3(one, one, one, add3), expected: option.Some(3)},
+		"map3_none":  {result: option.Map3(none, one, one, add3), expected: none},
+		"map4_some":  {result: option.Map4(one, one, one, one, add4), expected: option.Some(4)},
+		"map4_none":  {result: option.Map4(one, one, none, one, add4), expected: none},
+		"map5_some":  {result: option.Map5(one, one, one, one, one, add5), expected: option.Some(5)},
+		"map5_none":  {result: option.Map5(one, one, one, one, none, add5), expected: none},
+		"map6_some":  {result: option.Map6(one, one, one, one, one, one, add6), expected: option.Some(6)},
+		"map6_none":  {result: option.Map6(one, one, one, one, one, none, add6), expected: none},
+		"lift2_some": {result: option.Lift2(add2)(one, one), expected: option.Some(2)},
+		"lift2_none": {result: option.Lift2(add2)(none, one), expected: none},
+		"lift3_some": {result: option.Lift3(add3)(one, one, one), expected: option.Some(3)},
+		"lift4_some": {result: option.Lift4(add4)(one, one, one, one), expected: option.Some(4)},
+		"lift5_some": {result: option.Lift5(add5)(one, one, one, one, one), expected: option.Some(5)},
+		"lift6_some": {result: option.Lift6(add6)(one, one, one, one, one, one), expected: option.Some(6)},
+		"lift6_none": {result: option.Lift6(add6)(one, one, one, none, one, one), expected: none},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !equal(tc.result, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+func TestMap2DifferentTypes(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	newUser := func(name string, age int) user { return user{name: name, age: age} }
+
+	if !equal(option.Map2(option.Some("a"), option.Some(3), newUser), option.Some(user{name: "a", age: 3})) {
+		t.Fail()
+	}
+	if !equal(option.Map2(option.None[string](), option.Some(3), newUser), option.None[user]()) {
+		t.Fail()
+	}
+}